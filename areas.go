@@ -0,0 +1,110 @@
+package goentsoe
+
+//go:generate go run ./tools/genareas -in tools/genareas/areas.csv -out areas_gen.go
+
+import (
+	"strings"
+	"time"
+)
+
+// AreaKind tells what an area is used for. An area can be of several kinds,
+// e.g. most countries are bidding zone, control area and market balance area
+// at once.
+type AreaKind uint8
+
+const (
+	AreaKindBiddingZone AreaKind = 1 << iota
+	AreaKindControlArea
+	AreaKindCountry
+	AreaKindMarketBalanceArea
+)
+
+// Has reports whether k includes all kinds of kind.
+func (k AreaKind) Has(kind AreaKind) bool {
+	return k&kind == kind
+}
+
+func (k AreaKind) String() string {
+	var names []string
+	for _, kind := range []struct {
+		kind AreaKind
+		name string
+	}{
+		{AreaKindBiddingZone, "bidding zone"},
+		{AreaKindControlArea, "control area"},
+		{AreaKindCountry, "country"},
+		{AreaKindMarketBalanceArea, "market balance area"},
+	} {
+		if k.Has(kind.kind) {
+			names = append(names, kind.name)
+		}
+	}
+	return strings.Join(names, ", ")
+}
+
+// Area is an entry of the registry of ENTSO-E areas.
+type Area struct {
+	// Code is the EIC code used to query the area.
+	Code DomainType
+	// ShortName is the name commonly used in code, e.g. "DE_LU" or "NO_1".
+	ShortName string
+	// Name is the display name of the area.
+	Name     string
+	Kind     AreaKind
+	TimeZone string
+	// ValidFrom and ValidTo bound the time the area is in use. They are zero
+	// if the area existed before the transparency platform or still exists.
+	ValidFrom time.Time
+	ValidTo   time.Time
+}
+
+// Location returns the time zone of the area.
+func (a Area) Location() (*time.Location, error) {
+	return time.LoadLocation(a.TimeZone)
+}
+
+// ValidAt reports whether the area is in use at t.
+func (a Area) ValidAt(t time.Time) bool {
+	return (a.ValidFrom.IsZero() || !t.Before(a.ValidFrom)) && (a.ValidTo.IsZero() || t.Before(a.ValidTo))
+}
+
+var (
+	areasByCode      = make(map[DomainType]int, len(areas))
+	areasByShortName = make(map[string]int, len(areas))
+)
+
+func init() {
+	for i, a := range areas {
+		areasByCode[a.Code] = i
+		areasByShortName[a.ShortName] = i
+	}
+}
+
+// Areas returns all areas of the registry.
+func Areas() []Area {
+	return append([]Area(nil), areas...)
+}
+
+// LookupArea returns the area with the EIC code.
+func LookupArea(code DomainType) (Area, bool) {
+	i, ok := areasByCode[code]
+	if !ok {
+		return Area{}, false
+	}
+	return areas[i], true
+}
+
+// LookupAreaByName returns the area with the short name, e.g. "DE_LU". The
+// name is matched case insensitively and "-" may be used instead of "_".
+func LookupAreaByName(shortName string) (Area, bool) {
+	i, ok := areasByShortName[strings.ToUpper(strings.ReplaceAll(shortName, "-", "_"))]
+	if !ok {
+		return Area{}, false
+	}
+	return areas[i], true
+}
+
+// Area returns the registry entry of d.
+func (d DomainType) Area() (Area, bool) {
+	return LookupArea(d)
+}
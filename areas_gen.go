@@ -0,0 +1,703 @@
+// Code generated by go run ./tools/genareas; DO NOT EDIT.
+
+package goentsoe
+
+import "time"
+
+var areas = []Area{
+	{
+		Code:      "10YAL-KESH-----5",
+		ShortName: "AL",
+		Name:      "Albania",
+		Kind:      AreaKindBiddingZone | AreaKindControlArea | AreaKindCountry | AreaKindMarketBalanceArea,
+		TimeZone:  "Europe/Tirane",
+	},
+	{
+		Code:      "10YAT-APG------L",
+		ShortName: "AT",
+		Name:      "Austria",
+		Kind:      AreaKindBiddingZone | AreaKindControlArea | AreaKindCountry | AreaKindMarketBalanceArea,
+		TimeZone:  "Europe/Vienna",
+	},
+	{
+		Code:      "10YBA-JPCC-----D",
+		ShortName: "BA",
+		Name:      "Bosnia and Herzegovina",
+		Kind:      AreaKindBiddingZone | AreaKindControlArea | AreaKindCountry | AreaKindMarketBalanceArea,
+		TimeZone:  "Europe/Sarajevo",
+	},
+	{
+		Code:      "10YBE----------2",
+		ShortName: "BE",
+		Name:      "Belgium",
+		Kind:      AreaKindBiddingZone | AreaKindControlArea | AreaKindCountry | AreaKindMarketBalanceArea,
+		TimeZone:  "Europe/Brussels",
+	},
+	{
+		Code:      "10YCA-BULGARIA-R",
+		ShortName: "BG",
+		Name:      "Bulgaria",
+		Kind:      AreaKindBiddingZone | AreaKindControlArea | AreaKindCountry | AreaKindMarketBalanceArea,
+		TimeZone:  "Europe/Sofia",
+	},
+	{
+		Code:      "10Y1001A1001A51S",
+		ShortName: "BY",
+		Name:      "Belarus",
+		Kind:      AreaKindBiddingZone | AreaKindControlArea | AreaKindCountry | AreaKindMarketBalanceArea,
+		TimeZone:  "Europe/Minsk",
+	},
+	{
+		Code:      "10YCH-SWISSGRIDZ",
+		ShortName: "CH",
+		Name:      "Switzerland",
+		Kind:      AreaKindBiddingZone | AreaKindControlArea | AreaKindCountry | AreaKindMarketBalanceArea,
+		TimeZone:  "Europe/Zurich",
+	},
+	{
+		Code:      "10YCY-1001A0003J",
+		ShortName: "CY",
+		Name:      "Cyprus",
+		Kind:      AreaKindBiddingZone | AreaKindControlArea | AreaKindCountry | AreaKindMarketBalanceArea,
+		TimeZone:  "Asia/Nicosia",
+	},
+	{
+		Code:      "10YCZ-CEPS-----N",
+		ShortName: "CZ",
+		Name:      "Czech Republic",
+		Kind:      AreaKindBiddingZone | AreaKindControlArea | AreaKindCountry | AreaKindMarketBalanceArea,
+		TimeZone:  "Europe/Prague",
+	},
+	{
+		Code:      "10YDOM-CZ-DE-SKK",
+		ShortName: "CZ_DE_SK",
+		Name:      "Czech Republic-Germany-Slovakia",
+		Kind:      AreaKindBiddingZone,
+		TimeZone:  "Europe/Prague",
+	},
+	{
+		Code:      "10Y1001A1001A83F",
+		ShortName: "DE",
+		Name:      "Germany",
+		Kind:      AreaKindCountry,
+		TimeZone:  "Europe/Berlin",
+	},
+	{
+		Code:      "10YDE-VE-------2",
+		ShortName: "DE_50HZ",
+		Name:      "50Hertz",
+		Kind:      AreaKindControlArea,
+		TimeZone:  "Europe/Berlin",
+	},
+	{
+		Code:      "10YDE-RWENET---I",
+		ShortName: "DE_AMPRION",
+		Name:      "Amprion",
+		Kind:      AreaKindControlArea,
+		TimeZone:  "Europe/Berlin",
+	},
+	{
+		Code:      "10YDE-EON------1",
+		ShortName: "DE_TENNET",
+		Name:      "TenneT Germany",
+		Kind:      AreaKindControlArea,
+		TimeZone:  "Europe/Berlin",
+	},
+	{
+		Code:      "10YDE-ENBW-----N",
+		ShortName: "DE_TRANSNET",
+		Name:      "TransnetBW",
+		Kind:      AreaKindControlArea,
+		TimeZone:  "Europe/Berlin",
+	},
+	{
+		Code:      "10Y1001C--00002H",
+		ShortName: "DE_AMP_LU",
+		Name:      "Amprion Luxembourg",
+		Kind:      AreaKindControlArea,
+		TimeZone:  "Europe/Berlin",
+	},
+	{
+		Code:      "10Y1001A1001A63L",
+		ShortName: "DE_AT_LU",
+		Name:      "Germany-Austria-Luxembourg",
+		Kind:      AreaKindBiddingZone | AreaKindMarketBalanceArea,
+		TimeZone:  "Europe/Berlin",
+		ValidTo:   time.Date(2018, 9, 30, 22, 0, 0, 0, time.UTC),
+	},
+	{
+		Code:      "10Y1001A1001A82H",
+		ShortName: "DE_LU",
+		Name:      "Germany-Luxembourg",
+		Kind:      AreaKindBiddingZone | AreaKindMarketBalanceArea,
+		TimeZone:  "Europe/Berlin",
+		ValidFrom: time.Date(2018, 9, 30, 22, 0, 0, 0, time.UTC),
+	},
+	{
+		Code:      "10Y1001A1001A65H",
+		ShortName: "DK",
+		Name:      "Denmark",
+		Kind:      AreaKindCountry,
+		TimeZone:  "Europe/Copenhagen",
+	},
+	{
+		Code:      "10Y1001A1001A796",
+		ShortName: "DK_CA",
+		Name:      "Energinet",
+		Kind:      AreaKindControlArea,
+		TimeZone:  "Europe/Copenhagen",
+	},
+	{
+		Code:      "10YDK-1--------W",
+		ShortName: "DK_1",
+		Name:      "Denmark 1",
+		Kind:      AreaKindBiddingZone | AreaKindMarketBalanceArea,
+		TimeZone:  "Europe/Copenhagen",
+	},
+	{
+		Code:      "10YDK-2--------M",
+		ShortName: "DK_2",
+		Name:      "Denmark 2",
+		Kind:      AreaKindBiddingZone | AreaKindMarketBalanceArea,
+		TimeZone:  "Europe/Copenhagen",
+	},
+	{
+		Code:      "46Y000000000007M",
+		ShortName: "DK_1_NO_1",
+		Name:      "Denmark 1-Norway 1",
+		Kind:      AreaKindBiddingZone,
+		TimeZone:  "Europe/Copenhagen",
+	},
+	{
+		Code:      "10Y1001A1001A39I",
+		ShortName: "EE",
+		Name:      "Estonia",
+		Kind:      AreaKindBiddingZone | AreaKindControlArea | AreaKindCountry | AreaKindMarketBalanceArea,
+		TimeZone:  "Europe/Tallinn",
+	},
+	{
+		Code:      "10YES-REE------0",
+		ShortName: "ES",
+		Name:      "Spain",
+		Kind:      AreaKindBiddingZone | AreaKindControlArea | AreaKindCountry | AreaKindMarketBalanceArea,
+		TimeZone:  "Europe/Madrid",
+	},
+	{
+		Code:      "10YFI-1--------U",
+		ShortName: "FI",
+		Name:      "Finland",
+		Kind:      AreaKindBiddingZone | AreaKindControlArea | AreaKindCountry | AreaKindMarketBalanceArea,
+		TimeZone:  "Europe/Helsinki",
+	},
+	{
+		Code:      "10YFR-RTE------C",
+		ShortName: "FR",
+		Name:      "France",
+		Kind:      AreaKindBiddingZone | AreaKindControlArea | AreaKindCountry | AreaKindMarketBalanceArea,
+		TimeZone:  "Europe/Paris",
+	},
+	{
+		Code:      "10YGB----------A",
+		ShortName: "GB",
+		Name:      "Great Britain",
+		Kind:      AreaKindBiddingZone | AreaKindControlArea | AreaKindMarketBalanceArea,
+		TimeZone:  "Europe/London",
+	},
+	{
+		Code:      "10Y1001C--00098F",
+		ShortName: "GB_IFA",
+		Name:      "Great Britain IFA",
+		Kind:      AreaKindBiddingZone,
+		TimeZone:  "Europe/London",
+	},
+	{
+		Code:      "17Y0000009369493",
+		ShortName: "GB_IFA2",
+		Name:      "Great Britain IFA2",
+		Kind:      AreaKindBiddingZone,
+		TimeZone:  "Europe/London",
+	},
+	{
+		Code:      "11Y0-0000-0265-K",
+		ShortName: "GB_ELECLINK",
+		Name:      "Great Britain ElecLink",
+		Kind:      AreaKindBiddingZone,
+		TimeZone:  "Europe/London",
+	},
+	{
+		Code:      "10Y1001A1001A016",
+		ShortName: "GB_NIR",
+		Name:      "Northern Ireland",
+		Kind:      AreaKindControlArea,
+		TimeZone:  "Europe/London",
+	},
+	{
+		Code:      "10Y1001A1001A92E",
+		ShortName: "UK",
+		Name:      "United Kingdom",
+		Kind:      AreaKindCountry,
+		TimeZone:  "Europe/London",
+	},
+	{
+		Code:      "10Y1001A1001B012",
+		ShortName: "GE",
+		Name:      "Georgia",
+		Kind:      AreaKindBiddingZone | AreaKindControlArea | AreaKindCountry | AreaKindMarketBalanceArea,
+		TimeZone:  "Asia/Tbilisi",
+	},
+	{
+		Code:      "10YGR-HTSO-----Y",
+		ShortName: "GR",
+		Name:      "Greece",
+		Kind:      AreaKindBiddingZone | AreaKindControlArea | AreaKindCountry | AreaKindMarketBalanceArea,
+		TimeZone:  "Europe/Athens",
+	},
+	{
+		Code:      "10YHR-HEP------M",
+		ShortName: "HR",
+		Name:      "Croatia",
+		Kind:      AreaKindBiddingZone | AreaKindControlArea | AreaKindCountry | AreaKindMarketBalanceArea,
+		TimeZone:  "Europe/Zagreb",
+	},
+	{
+		Code:      "10YHU-MAVIR----U",
+		ShortName: "HU",
+		Name:      "Hungary",
+		Kind:      AreaKindBiddingZone | AreaKindControlArea | AreaKindCountry | AreaKindMarketBalanceArea,
+		TimeZone:  "Europe/Budapest",
+	},
+	{
+		Code:      "10YIE-1001A00010",
+		ShortName: "IE",
+		Name:      "Ireland",
+		Kind:      AreaKindControlArea | AreaKindCountry,
+		TimeZone:  "Europe/Dublin",
+	},
+	{
+		Code:      "10Y1001A1001A59C",
+		ShortName: "IE_SEM",
+		Name:      "Ireland Single Electricity Market",
+		Kind:      AreaKindBiddingZone | AreaKindMarketBalanceArea,
+		TimeZone:  "Europe/Dublin",
+		ValidFrom: time.Date(2018, 9, 30, 23, 0, 0, 0, time.UTC),
+	},
+	{
+		Code:      "10YIT-GRTN-----B",
+		ShortName: "IT",
+		Name:      "Italy",
+		Kind:      AreaKindControlArea | AreaKindCountry | AreaKindMarketBalanceArea,
+		TimeZone:  "Europe/Rome",
+	},
+	{
+		Code:      "10Y1001A1001A73I",
+		ShortName: "IT_NORD",
+		Name:      "Italy North",
+		Kind:      AreaKindBiddingZone,
+		TimeZone:  "Europe/Rome",
+	},
+	{
+		Code:      "10Y1001A1001A70O",
+		ShortName: "IT_CNOR",
+		Name:      "Italy Centre-North",
+		Kind:      AreaKindBiddingZone,
+		TimeZone:  "Europe/Rome",
+	},
+	{
+		Code:      "10Y1001A1001A71M",
+		ShortName: "IT_CSUD",
+		Name:      "Italy Centre-South",
+		Kind:      AreaKindBiddingZone,
+		TimeZone:  "Europe/Rome",
+	},
+	{
+		Code:      "10Y1001A1001A788",
+		ShortName: "IT_SUD",
+		Name:      "Italy South",
+		Kind:      AreaKindBiddingZone,
+		TimeZone:  "Europe/Rome",
+	},
+	{
+		Code:      "10Y1001C--00096J",
+		ShortName: "IT_CALA",
+		Name:      "Italy Calabria",
+		Kind:      AreaKindBiddingZone,
+		TimeZone:  "Europe/Rome",
+	},
+	{
+		Code:      "10Y1001A1001A74G",
+		ShortName: "IT_SARD",
+		Name:      "Italy Sardinia",
+		Kind:      AreaKindBiddingZone,
+		TimeZone:  "Europe/Rome",
+	},
+	{
+		Code:      "10Y1001A1001A75E",
+		ShortName: "IT_SICI",
+		Name:      "Italy Sicily",
+		Kind:      AreaKindBiddingZone,
+		TimeZone:  "Europe/Rome",
+	},
+	{
+		Code:      "10Y1001A1001A699",
+		ShortName: "IT_BRNN",
+		Name:      "Italy Brindisi",
+		Kind:      AreaKindBiddingZone,
+		TimeZone:  "Europe/Rome",
+	},
+	{
+		Code:      "10Y1001A1001A72K",
+		ShortName: "IT_FOGN",
+		Name:      "Italy Foggia",
+		Kind:      AreaKindBiddingZone,
+		TimeZone:  "Europe/Rome",
+	},
+	{
+		Code:      "10Y1001A1001A76C",
+		ShortName: "IT_PRGP",
+		Name:      "Italy Priolo",
+		Kind:      AreaKindBiddingZone,
+		TimeZone:  "Europe/Rome",
+	},
+	{
+		Code:      "10Y1001A1001A77A",
+		ShortName: "IT_ROSN",
+		Name:      "Italy Rossano",
+		Kind:      AreaKindBiddingZone,
+		TimeZone:  "Europe/Rome",
+	},
+	{
+		Code:      "10Y1001A1001A66F",
+		ShortName: "IT_GR",
+		Name:      "Italy Greece",
+		Kind:      AreaKindBiddingZone,
+		TimeZone:  "Europe/Rome",
+	},
+	{
+		Code:      "10Y1001A1001A877",
+		ShortName: "IT_MALTA",
+		Name:      "Italy Malta",
+		Kind:      AreaKindBiddingZone,
+		TimeZone:  "Europe/Rome",
+	},
+	{
+		Code:      "10Y1001A1001A885",
+		ShortName: "IT_SACO_AC",
+		Name:      "Italy SACOI AC",
+		Kind:      AreaKindBiddingZone,
+		TimeZone:  "Europe/Rome",
+	},
+	{
+		Code:      "10Y1001A1001A893",
+		ShortName: "IT_SACO_DC",
+		Name:      "Italy SACOI DC",
+		Kind:      AreaKindBiddingZone,
+		TimeZone:  "Europe/Rome",
+	},
+	{
+		Code:      "10Y1001A1001A80L",
+		ShortName: "IT_NORD_AT",
+		Name:      "Italy North-Austria",
+		Kind:      AreaKindBiddingZone,
+		TimeZone:  "Europe/Rome",
+	},
+	{
+		Code:      "10Y1001A1001A68B",
+		ShortName: "IT_NORD_CH",
+		Name:      "Italy North-Switzerland",
+		Kind:      AreaKindBiddingZone,
+		TimeZone:  "Europe/Rome",
+	},
+	{
+		Code:      "10Y1001A1001A81J",
+		ShortName: "IT_NORD_FR",
+		Name:      "Italy North-France",
+		Kind:      AreaKindBiddingZone,
+		TimeZone:  "Europe/Rome",
+	},
+	{
+		Code:      "10Y1001A1001A67D",
+		ShortName: "IT_NORD_SI",
+		Name:      "Italy North-Slovenia",
+		Kind:      AreaKindBiddingZone,
+		TimeZone:  "Europe/Rome",
+	},
+	{
+		Code:      "10Y1001A1001A84D",
+		ShortName: "IT_MACRO_NORTH",
+		Name:      "Italy Macrozone North",
+		Kind:      AreaKindMarketBalanceArea,
+		TimeZone:  "Europe/Rome",
+	},
+	{
+		Code:      "10Y1001A1001A85B",
+		ShortName: "IT_MACRO_SOUTH",
+		Name:      "Italy Macrozone South",
+		Kind:      AreaKindMarketBalanceArea,
+		TimeZone:  "Europe/Rome",
+	},
+	{
+		Code:      "10Y1001C--00100H",
+		ShortName: "XK",
+		Name:      "Kosovo",
+		Kind:      AreaKindBiddingZone | AreaKindControlArea | AreaKindMarketBalanceArea,
+		TimeZone:  "Europe/Belgrade",
+	},
+	{
+		Code:      "10YLT-1001A0008Q",
+		ShortName: "LT",
+		Name:      "Lithuania",
+		Kind:      AreaKindBiddingZone | AreaKindControlArea | AreaKindCountry | AreaKindMarketBalanceArea,
+		TimeZone:  "Europe/Vilnius",
+	},
+	{
+		Code:      "10YLU-CEGEDEL-NQ",
+		ShortName: "LU",
+		Name:      "Luxembourg",
+		Kind:      AreaKindControlArea | AreaKindCountry,
+		TimeZone:  "Europe/Luxembourg",
+	},
+	{
+		Code:      "10YLV-1001A00074",
+		ShortName: "LV",
+		Name:      "Latvia",
+		Kind:      AreaKindBiddingZone | AreaKindControlArea | AreaKindCountry | AreaKindMarketBalanceArea,
+		TimeZone:  "Europe/Riga",
+	},
+	{
+		Code:      "10Y1001A1001A990",
+		ShortName: "MD",
+		Name:      "Moldova",
+		Kind:      AreaKindBiddingZone | AreaKindControlArea | AreaKindCountry | AreaKindMarketBalanceArea,
+		TimeZone:  "Europe/Chisinau",
+	},
+	{
+		Code:      "10YCS-CG-TSO---S",
+		ShortName: "ME",
+		Name:      "Montenegro",
+		Kind:      AreaKindBiddingZone | AreaKindControlArea | AreaKindCountry | AreaKindMarketBalanceArea,
+		TimeZone:  "Europe/Podgorica",
+	},
+	{
+		Code:      "10YMK-MEPSO----8",
+		ShortName: "MK",
+		Name:      "North Macedonia",
+		Kind:      AreaKindBiddingZone | AreaKindControlArea | AreaKindCountry | AreaKindMarketBalanceArea,
+		TimeZone:  "Europe/Skopje",
+	},
+	{
+		Code:      "10Y1001A1001A93C",
+		ShortName: "MT",
+		Name:      "Malta",
+		Kind:      AreaKindBiddingZone | AreaKindControlArea | AreaKindCountry | AreaKindMarketBalanceArea,
+		TimeZone:  "Europe/Malta",
+	},
+	{
+		Code:      "10YNL----------L",
+		ShortName: "NL",
+		Name:      "Netherlands",
+		Kind:      AreaKindBiddingZone | AreaKindControlArea | AreaKindCountry | AreaKindMarketBalanceArea,
+		TimeZone:  "Europe/Amsterdam",
+	},
+	{
+		Code:      "10YNO-0--------C",
+		ShortName: "NO",
+		Name:      "Norway",
+		Kind:      AreaKindControlArea | AreaKindCountry | AreaKindMarketBalanceArea,
+		TimeZone:  "Europe/Oslo",
+	},
+	{
+		Code:      "10YNO-1--------2",
+		ShortName: "NO_1",
+		Name:      "Norway 1",
+		Kind:      AreaKindBiddingZone | AreaKindMarketBalanceArea,
+		TimeZone:  "Europe/Oslo",
+	},
+	{
+		Code:      "10Y1001A1001A64J",
+		ShortName: "NO_1A",
+		Name:      "Norway 1A",
+		Kind:      AreaKindBiddingZone,
+		TimeZone:  "Europe/Oslo",
+	},
+	{
+		Code:      "10YNO-2--------T",
+		ShortName: "NO_2",
+		Name:      "Norway 2",
+		Kind:      AreaKindBiddingZone | AreaKindMarketBalanceArea,
+		TimeZone:  "Europe/Oslo",
+	},
+	{
+		Code:      "10Y1001C--001219",
+		ShortName: "NO_2A",
+		Name:      "Norway 2A",
+		Kind:      AreaKindBiddingZone,
+		TimeZone:  "Europe/Oslo",
+	},
+	{
+		Code:      "50Y0JVU59B4JWQCU",
+		ShortName: "NO_2_NSL",
+		Name:      "Norway 2 North Sea Link",
+		Kind:      AreaKindBiddingZone | AreaKindMarketBalanceArea,
+		TimeZone:  "Europe/Oslo",
+	},
+	{
+		Code:      "10YNO-3--------J",
+		ShortName: "NO_3",
+		Name:      "Norway 3",
+		Kind:      AreaKindBiddingZone | AreaKindMarketBalanceArea,
+		TimeZone:  "Europe/Oslo",
+	},
+	{
+		Code:      "10YNO-4--------9",
+		ShortName: "NO_4",
+		Name:      "Norway 4",
+		Kind:      AreaKindBiddingZone | AreaKindMarketBalanceArea,
+		TimeZone:  "Europe/Oslo",
+	},
+	{
+		Code:      "10Y1001A1001A48H",
+		ShortName: "NO_5",
+		Name:      "Norway 5",
+		Kind:      AreaKindBiddingZone | AreaKindMarketBalanceArea,
+		TimeZone:  "Europe/Oslo",
+	},
+	{
+		Code:      "10YPL-AREA-----S",
+		ShortName: "PL",
+		Name:      "Poland",
+		Kind:      AreaKindBiddingZone | AreaKindControlArea | AreaKindCountry | AreaKindMarketBalanceArea,
+		TimeZone:  "Europe/Warsaw",
+	},
+	{
+		Code:      "10YDOM-1001A082L",
+		ShortName: "PL_CZ",
+		Name:      "Poland-Czech Republic",
+		Kind:      AreaKindControlArea,
+		TimeZone:  "Europe/Warsaw",
+	},
+	{
+		Code:      "10YPT-REN------W",
+		ShortName: "PT",
+		Name:      "Portugal",
+		Kind:      AreaKindBiddingZone | AreaKindControlArea | AreaKindCountry | AreaKindMarketBalanceArea,
+		TimeZone:  "Europe/Lisbon",
+	},
+	{
+		Code:      "10YRO-TEL------P",
+		ShortName: "RO",
+		Name:      "Romania",
+		Kind:      AreaKindBiddingZone | AreaKindControlArea | AreaKindCountry | AreaKindMarketBalanceArea,
+		TimeZone:  "Europe/Bucharest",
+	},
+	{
+		Code:      "10YCS-SERBIATSOV",
+		ShortName: "RS",
+		Name:      "Serbia",
+		Kind:      AreaKindBiddingZone | AreaKindControlArea | AreaKindCountry | AreaKindMarketBalanceArea,
+		TimeZone:  "Europe/Belgrade",
+	},
+	{
+		Code:      "10Y1001A1001A49F",
+		ShortName: "RU",
+		Name:      "Russia",
+		Kind:      AreaKindBiddingZone | AreaKindControlArea | AreaKindCountry | AreaKindMarketBalanceArea,
+		TimeZone:  "Europe/Moscow",
+	},
+	{
+		Code:      "10Y1001A1001A50U",
+		ShortName: "RU_KGD",
+		Name:      "Kaliningrad",
+		Kind:      AreaKindBiddingZone | AreaKindControlArea | AreaKindMarketBalanceArea,
+		TimeZone:  "Europe/Kaliningrad",
+	},
+	{
+		Code:      "10YSE-1--------K",
+		ShortName: "SE",
+		Name:      "Sweden",
+		Kind:      AreaKindControlArea | AreaKindCountry | AreaKindMarketBalanceArea,
+		TimeZone:  "Europe/Stockholm",
+	},
+	{
+		Code:      "10Y1001A1001A44P",
+		ShortName: "SE_1",
+		Name:      "Sweden 1",
+		Kind:      AreaKindBiddingZone | AreaKindMarketBalanceArea,
+		TimeZone:  "Europe/Stockholm",
+	},
+	{
+		Code:      "10Y1001A1001A45N",
+		ShortName: "SE_2",
+		Name:      "Sweden 2",
+		Kind:      AreaKindBiddingZone | AreaKindMarketBalanceArea,
+		TimeZone:  "Europe/Stockholm",
+	},
+	{
+		Code:      "10Y1001A1001A46L",
+		ShortName: "SE_3",
+		Name:      "Sweden 3",
+		Kind:      AreaKindBiddingZone | AreaKindMarketBalanceArea,
+		TimeZone:  "Europe/Stockholm",
+	},
+	{
+		Code:      "10Y1001A1001A47J",
+		ShortName: "SE_4",
+		Name:      "Sweden 4",
+		Kind:      AreaKindBiddingZone | AreaKindMarketBalanceArea,
+		TimeZone:  "Europe/Stockholm",
+	},
+	{
+		Code:      "10YSI-ELES-----O",
+		ShortName: "SI",
+		Name:      "Slovenia",
+		Kind:      AreaKindBiddingZone | AreaKindControlArea | AreaKindCountry | AreaKindMarketBalanceArea,
+		TimeZone:  "Europe/Ljubljana",
+	},
+	{
+		Code:      "10YSK-SEPS-----K",
+		ShortName: "SK",
+		Name:      "Slovakia",
+		Kind:      AreaKindBiddingZone | AreaKindControlArea | AreaKindCountry | AreaKindMarketBalanceArea,
+		TimeZone:  "Europe/Bratislava",
+	},
+	{
+		Code:      "10YTR-TEIAS----W",
+		ShortName: "TR",
+		Name:      "Turkey",
+		Kind:      AreaKindBiddingZone | AreaKindControlArea | AreaKindCountry | AreaKindMarketBalanceArea,
+		TimeZone:  "Europe/Istanbul",
+	},
+	{
+		Code:      "10Y1001C--00003F",
+		ShortName: "UA",
+		Name:      "Ukraine",
+		Kind:      AreaKindBiddingZone | AreaKindCountry | AreaKindMarketBalanceArea,
+		TimeZone:  "Europe/Kiev",
+	},
+	{
+		Code:      "10YUA-WEPS-----0",
+		ShortName: "UA_BEI",
+		Name:      "Ukraine Burshtyn Energy Island",
+		Kind:      AreaKindControlArea,
+		TimeZone:  "Europe/Kiev",
+	},
+	{
+		Code:      "10Y1001A1001A869",
+		ShortName: "UA_DOBTPP",
+		Name:      "Ukraine Dobrotvir TPP",
+		Kind:      AreaKindControlArea,
+		TimeZone:  "Europe/Kiev",
+	},
+	{
+		Code:      "10Y1001C--000182",
+		ShortName: "UA_IPS",
+		Name:      "Ukraine IPS",
+		Kind:      AreaKindControlArea,
+		TimeZone:  "Europe/Kiev",
+	},
+	{
+		Code:      "10YDOM-REGION-1V",
+		ShortName: "CWE",
+		Name:      "Central Western Europe",
+		TimeZone:  "Europe/Brussels",
+	},
+}
@@ -0,0 +1,71 @@
+package goentsoe
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLookupArea(t *testing.T) {
+	a, ok := LookupArea(DomainDELU)
+	assert.True(t, ok)
+	assert.Equal(t, "DE_LU", a.ShortName)
+	assert.True(t, a.Kind.Has(AreaKindBiddingZone))
+	assert.False(t, a.Kind.Has(AreaKindControlArea))
+	assert.Equal(t, "bidding zone, market balance area", a.Kind.String())
+	assert.False(t, a.ValidAt(time.Date(2018, 9, 30, 21, 0, 0, 0, time.UTC)))
+	assert.True(t, a.ValidAt(time.Date(2018, 9, 30, 22, 0, 0, 0, time.UTC)))
+
+	old, ok := DomainDEATLU.Area()
+	assert.True(t, ok)
+	assert.True(t, old.ValidAt(time.Date(2018, 9, 30, 21, 0, 0, 0, time.UTC)))
+	assert.False(t, old.ValidAt(a.ValidFrom))
+
+	_, ok = LookupArea("10YXX-UNKNOWN--0")
+	assert.False(t, ok)
+}
+
+func TestLookupAreaByName(t *testing.T) {
+	for _, name := range []string{"DE_LU", "de-lu"} {
+		a, ok := LookupAreaByName(name)
+		assert.True(t, ok)
+		assert.Equal(t, DomainDELU, a.Code)
+	}
+	a, ok := LookupAreaByName("NO_2")
+	assert.True(t, ok)
+	assert.Equal(t, DomainNO2, a.Code)
+	loc, err := a.Location()
+	assert.Nil(t, err)
+	assert.Equal(t, "Europe/Oslo", loc.String())
+
+	_, ok = LookupAreaByName("DE_XX")
+	assert.False(t, ok)
+}
+
+func TestDomainConstantsAreRegistered(t *testing.T) {
+	for _, d := range []DomainType{
+		DomainAL, DomainAT, DomainBA, DomainBE, DomainBG, DomainBY, DomainCH,
+		DomainCZ, DomainDE, DomainDE50Hertz, DomainDEAmprion, DomainDETenneT,
+		DomainDETransnetBW, DomainDK, DomainEE, DomainES, DomainFI, DomainFR,
+		DomainGB, DomainGBNIR, DomainGR, DomainHR, DomainHU, DomainIE, DomainIT,
+		DomainLT, DomainLU, DomainLV, DomainME, DomainMK, DomainMT, DomainNL,
+		DomainNO, DomainPL, DomainPT, DomainRO, DomainRS, DomainRU, DomainRUKGD,
+		DomainSE, DomainSI, DomainSK, DomainTR, DomainUA, DomainDEATLU,
+		DomainDELU, DomainCY, DomainMD, DomainXK, DomainIESEM, DomainDK1,
+		DomainDK2, DomainNO1, DomainNO2, DomainNO3, DomainNO4, DomainNO5,
+		DomainSE1, DomainSE2, DomainSE3, DomainSE4, DomainITNorth,
+		DomainITCentreNorth, DomainITCentreSouth, DomainITSouth,
+		DomainITCalabria, DomainITSardinia, DomainITSicily, DomainCWE,
+	} {
+		_, ok := d.Area()
+		assert.True(t, ok, d)
+	}
+}
+
+func TestAreasHaveValidTimeZones(t *testing.T) {
+	for _, a := range Areas() {
+		_, err := a.Location()
+		assert.Nil(t, err, a.ShortName)
+	}
+}
@@ -0,0 +1,213 @@
+package goentsoe
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"time"
+)
+
+// Border is an interconnection between two bidding zones.
+type Border struct {
+	A DomainType
+	B DomainType
+}
+
+// Borders lists the interconnections between the bidding zones currently in
+// use for which cross-border flows and schedules are published. It is
+// maintained by hand from the border list of the transparency platform and
+// needs an update when interconnectors are commissioned or bidding zones
+// change; use CrossBorderFlowsWith for borders not listed.
+var Borders = []Border{
+	{DomainAL, DomainGR}, {DomainAL, DomainME}, {DomainAL, DomainRS},
+	{DomainAT, DomainCH}, {DomainAT, DomainCZ}, {DomainAT, DomainDELU},
+	{DomainAT, DomainHU}, {DomainAT, DomainITNorth}, {DomainAT, DomainSI},
+	{DomainBA, DomainHR}, {DomainBA, DomainME}, {DomainBA, DomainRS},
+	{DomainBE, DomainDELU}, {DomainBE, DomainFR}, {DomainBE, DomainGB},
+	{DomainBE, DomainNL},
+	{DomainBG, DomainGR}, {DomainBG, DomainMK}, {DomainBG, DomainRO},
+	{DomainBG, DomainRS}, {DomainBG, DomainTR},
+	{DomainCH, DomainDELU}, {DomainCH, DomainFR}, {DomainCH, DomainITNorth},
+	{DomainCZ, DomainDELU}, {DomainCZ, DomainPL}, {DomainCZ, DomainSK},
+	{DomainDELU, DomainDK1}, {DomainDELU, DomainDK2}, {DomainDELU, DomainFR},
+	{DomainDELU, DomainNL}, {DomainDELU, DomainNO2}, {DomainDELU, DomainPL},
+	{DomainDELU, DomainSE4},
+	{DomainDK1, DomainDK2}, {DomainDK1, DomainNL}, {DomainDK1, DomainNO2},
+	{DomainDK1, DomainGB}, {DomainDK1, DomainSE3}, {DomainDK2, DomainSE4},
+	{DomainEE, DomainFI}, {DomainEE, DomainLV},
+	{DomainES, DomainFR}, {DomainES, DomainPT},
+	{DomainFI, DomainNO4}, {DomainFI, DomainSE1}, {DomainFI, DomainSE3},
+	{DomainFR, DomainGB}, {DomainFR, DomainITNorth},
+	{DomainGB, DomainIESEM}, {DomainGB, DomainNL}, {DomainGB, DomainNO2},
+	{DomainGR, DomainITSouth}, {DomainGR, DomainMK}, {DomainGR, DomainTR},
+	{DomainHR, DomainHU}, {DomainHR, DomainRS}, {DomainHR, DomainSI},
+	{DomainHU, DomainRO}, {DomainHU, DomainRS}, {DomainHU, DomainSI},
+	{DomainHU, DomainSK}, {DomainHU, DomainUA},
+	{DomainITNorth, DomainITCentreNorth}, {DomainITNorth, DomainSI},
+	{DomainITCentreNorth, DomainITCentreSouth}, {DomainITCentreNorth, DomainITSardinia},
+	{DomainITCentreSouth, DomainITSardinia}, {DomainITCentreSouth, DomainITSouth},
+	{DomainITCentreSouth, DomainME},
+	{DomainITSouth, DomainITCalabria}, {DomainITCalabria, DomainITSicily},
+	{DomainITSicily, DomainMT},
+	{DomainLT, DomainBY}, {DomainLT, DomainLV}, {DomainLT, DomainPL},
+	{DomainLT, DomainRUKGD}, {DomainLT, DomainSE4},
+	{DomainME, DomainRS}, {DomainMK, DomainRS},
+	{DomainNL, DomainNO2},
+	{DomainNO1, DomainNO2}, {DomainNO1, DomainNO3}, {DomainNO1, DomainNO5},
+	{DomainNO1, DomainSE3}, {DomainNO2, DomainNO5}, {DomainNO3, DomainNO4},
+	{DomainNO3, DomainNO5}, {DomainNO3, DomainSE2}, {DomainNO4, DomainSE1},
+	{DomainNO4, DomainSE2},
+	{DomainPL, DomainSE4}, {DomainPL, DomainSK}, {DomainPL, DomainUA},
+	{DomainRO, DomainRS}, {DomainRO, DomainUA},
+	{DomainSE1, DomainSE2}, {DomainSE2, DomainSE3}, {DomainSE3, DomainSE4},
+	{DomainSK, DomainUA},
+	{DomainXK, DomainAL}, {DomainXK, DomainME}, {DomainXK, DomainMK},
+	{DomainXK, DomainRS},
+}
+
+// Neighbours returns the bidding zones sharing a border with zone in
+// ascending order.
+func Neighbours(zone DomainType) []DomainType {
+	var res []DomainType
+	for _, b := range Borders {
+		switch zone {
+		case b.A:
+			res = append(res, b.B)
+		case b.B:
+			res = append(res, b.A)
+		}
+	}
+	sort.Slice(res, func(i, j int) bool {
+		return res[i] < res[j]
+	})
+	return res
+}
+
+// DefaultBorderConcurrency is the number of border directions
+// CrossBorderFlows fetches in parallel unless set with
+// WithBorderConcurrency.
+const DefaultBorderConcurrency = 4
+
+// WithBorderConcurrency sets how many border directions CrossBorderFlows
+// fetches in parallel. Parallel requests still share the client's rate
+// limiter.
+func WithBorderConcurrency(n int) Option {
+	return func(c *EntsoeClient) {
+		c.borderConcurrency = n
+	}
+}
+
+// FlowKind selects the cross-border flows to fetch.
+type FlowKind int
+
+const (
+	// FlowKindPhysical are the measured physical flows.
+	FlowKindPhysical FlowKind = iota
+	// FlowKindScheduled are the total commercial schedules.
+	FlowKindScheduled
+)
+
+// CrossBorderFlows holds the flows between a zone and its neighbours in MW.
+// All values are indexed by neighbour and row: Import[n][i] is the flow from
+// Neighbours[n] into Zone over the row starting at Times[i]. A direction for
+// which no data is published at all counts as zero flow. Rows a direction
+// covers only partly are NaN, as are the net values depending on them.
+type CrossBorderFlows struct {
+	Zone       DomainType
+	Kind       FlowKind
+	Neighbours []DomainType
+	// Resolution is the length of every row. Borders published in a finer
+	// resolution are averaged to the coarsest resolution of all borders.
+	Resolution time.Duration
+	// Times holds the start of every row in ascending order.
+	Times  []time.Time
+	Import [][]float64
+	Export [][]float64
+	// Net is the import minus the export of every border.
+	Net [][]float64
+	// NetImport is the sum of Net over all borders. It is negative while
+	// the zone exports.
+	NetImport []float64
+}
+
+// CrossBorderFlows fetches the flows in both directions on all borders of
+// zone listed in Borders and computes the net flows. The directions are
+// fetched concurrently as configured with WithBorderConcurrency. It returns
+// ErrNoMatchingData only if there is no data for any border.
+func (c *EntsoeClient) CrossBorderFlows(ctx context.Context, zone DomainType, periodStart, periodEnd time.Time, kind FlowKind) (*CrossBorderFlows, error) {
+	return c.CrossBorderFlowsWith(ctx, zone, Neighbours(zone), periodStart, periodEnd, kind)
+}
+
+// CrossBorderFlowsWith is CrossBorderFlows for the borders of zone with the
+// given neighbours.
+func (c *EntsoeClient) CrossBorderFlowsWith(ctx context.Context, zone DomainType, neighbours []DomainType, periodStart, periodEnd time.Time, kind FlowKind) (*CrossBorderFlows, error) {
+	// Request 2n fetches the import from neighbour n, 2n+1 the export.
+	series := make([][]TimeSeries, 2*len(neighbours))
+	err := fanOut(ctx, len(series), c.borderConcurrency, func(ctx context.Context, i int) error {
+		in, out := zone, neighbours[i/2]
+		if i%2 == 1 {
+			in, out = out, in
+		}
+		var doc *PublicationMarketDocument
+		var err error
+		switch kind {
+		case FlowKindScheduled:
+			doc, err = c.GetTotalCommercialSchedulesCtx(ctx, in, out, periodStart, periodEnd, nil)
+		default:
+			doc, err = c.GetPhysicalFlowsCtx(ctx, in, out, periodStart, periodEnd)
+		}
+		if errors.Is(err, ErrNoMatchingData) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		series[i], err = ConvertPublicationMarketDocument(doc)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var all []TimeSeries
+	for _, s := range series {
+		all = append(all, s...)
+	}
+	if len(all) == 0 {
+		return nil, ErrNoMatchingData
+	}
+	flows := &CrossBorderFlows{
+		Zone:       zone,
+		Kind:       kind,
+		Neighbours: append([]DomainType(nil), neighbours...),
+		Resolution: coarsestResolution(all),
+	}
+	loc := periodLocation(zone)
+	columns := make([]map[time.Time]float64, len(series))
+	for i, s := range series {
+		if len(s) > 0 {
+			columns[i] = resample(s, flows.Resolution, loc)
+		}
+	}
+	flows.Times = intervalStarts(columns...)
+
+	column := func(i int) []float64 {
+		if columns[i] == nil {
+			return make([]float64, len(flows.Times))
+		}
+		return alignColumn(flows.Times, columns[i])
+	}
+	flows.NetImport = make([]float64, len(flows.Times))
+	for n := range neighbours {
+		imports, exports := column(2*n), column(2*n+1)
+		net := make([]float64, len(flows.Times))
+		for i := range net {
+			net[i] = imports[i] - exports[i]
+			flows.NetImport[i] += net[i]
+		}
+		flows.Import = append(flows.Import, imports)
+		flows.Export = append(flows.Export, exports)
+		flows.Net = append(flows.Net, net)
+	}
+	return flows, nil
+}
@@ -0,0 +1,162 @@
+package goentsoe
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func flowDocument(in, out DomainType, resolution string, quantities ...string) string {
+	points := ""
+	for i, q := range quantities {
+		if q != "" {
+			points += fmt.Sprintf("<Point><position>%d</position><quantity>%s</quantity></Point>", i+1, q)
+		}
+	}
+	return fmt.Sprintf(`<?xml version="1.0" encoding="UTF-8"?>
+<Publication_MarketDocument xmlns="urn:iec62325.351:tc57wg16:451-3:publicationdocument:7:0">
+	<mRID>1</mRID>
+	<type>A11</type>
+	<TimeSeries>
+		<mRID>1</mRID>
+		<businessType>A66</businessType>
+		<in_Domain.mRID codingScheme="A01">%s</in_Domain.mRID>
+		<out_Domain.mRID codingScheme="A01">%s</out_Domain.mRID>
+		<quantity_Measure_Unit.name>MAW</quantity_Measure_Unit.name>
+		<curveType>A01</curveType>
+		<Period>
+			<timeInterval><start>2023-06-01T10:00Z</start><end>2023-06-01T12:00Z</end></timeInterval>
+			<resolution>%s</resolution>
+			%s
+		</Period>
+	</TimeSeries>
+</Publication_MarketDocument>`, in, out, resolution, points)
+}
+
+func TestCrossBorderFlows(t *testing.T) {
+	var mu sync.Mutex
+	var documentTypes []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		mu.Lock()
+		documentTypes = append(documentTypes, q.Get(ParameterDocumentType))
+		mu.Unlock()
+		in, out := DomainType(q.Get(ParameterInDomain)), DomainType(q.Get(ParameterOutDomain))
+		switch {
+		case in == DomainCZ && out == DomainSK:
+			w.Write([]byte(flowDocument(in, out, "PT60M", "100", "200")))
+		case in == DomainSK && out == DomainCZ:
+			w.Write([]byte(flowDocument(in, out, "PT60M", "30", "30")))
+		case in == DomainPL && out == DomainCZ:
+			w.Write([]byte(flowDocument(in, out, "PT60M", "50", "")))
+		default:
+			w.Write([]byte(noMatchingDataAcknowledgement))
+		}
+	}))
+	defer server.Close()
+
+	c := NewEntsoeClient("token", WithBaseURL(server.URL), WithConcurrency(4))
+	neighbours := []DomainType{DomainSK, DomainPL}
+	flows, err := c.CrossBorderFlowsWith(
+		context.Background(),
+		DomainCZ,
+		neighbours,
+		time.Date(2023, 6, 1, 10, 0, 0, 0, time.UTC),
+		time.Date(2023, 6, 1, 12, 0, 0, 0, time.UTC),
+		FlowKindScheduled,
+	)
+	assert.Nil(t, err)
+	assert.Len(t, documentTypes, 4)
+	assert.Equal(t, string(DocumentTypeFinalisedSchedule), documentTypes[0])
+	assert.Equal(t, []time.Time{
+		time.Date(2023, 6, 1, 10, 0, 0, 0, time.UTC),
+		time.Date(2023, 6, 1, 11, 0, 0, 0, time.UTC),
+	}, flows.Times)
+	assert.Equal(t, []float64{70, 170}, flows.Net[0])
+	assert.Equal(t, []float64{0, 0}, flows.Import[1])
+	assert.Equal(t, -50.0, flows.Net[1][0])
+	assert.True(t, math.IsNaN(flows.Export[1][1]))
+	assert.Equal(t, 20.0, flows.NetImport[0])
+	assert.True(t, math.IsNaN(flows.NetImport[1]))
+	neighbours[0] = DomainAT
+	assert.Equal(t, []DomainType{DomainSK, DomainPL}, flows.Neighbours)
+}
+
+func TestCrossBorderFlowsHarmonisesResolutions(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		in, out := DomainType(q.Get(ParameterInDomain)), DomainType(q.Get(ParameterOutDomain))
+		switch {
+		case in == DomainDELU && out == DomainNL:
+			w.Write([]byte(flowDocument(in, out, "PT15M", "100", "200", "300", "400", "100", "100", "100", "100")))
+		case in == DomainDELU && out == DomainCH:
+			w.Write([]byte(flowDocument(in, out, "PT60M", "500", "600")))
+		case in == DomainCH && out == DomainDELU:
+			w.Write([]byte(flowDocument(in, out, "PT15M", "40", "40", "40", "40", "40", "", "40", "40")))
+		default:
+			w.Write([]byte(noMatchingDataAcknowledgement))
+		}
+	}))
+	defer server.Close()
+
+	c := NewEntsoeClient("token", WithBaseURL(server.URL))
+	start := time.Date(2023, 6, 1, 10, 0, 0, 0, time.UTC)
+	flows, err := c.CrossBorderFlowsWith(context.Background(), DomainDELU, []DomainType{DomainNL, DomainCH}, start, start.Add(2*time.Hour), FlowKindPhysical)
+	assert.Nil(t, err)
+	assert.Equal(t, time.Hour, flows.Resolution)
+	assert.Equal(t, []time.Time{start, start.Add(time.Hour)}, flows.Times)
+	assert.Equal(t, []float64{250, 100}, flows.Import[0])
+	assert.Equal(t, []float64{0, 0}, flows.Export[0])
+	assert.Equal(t, []float64{500, 600}, flows.Import[1])
+	assert.Equal(t, 40.0, flows.Export[1][0])
+	// The quarter-hour missing from the second hour makes it unknown.
+	assert.True(t, math.IsNaN(flows.Export[1][1]))
+	assert.Equal(t, 710.0, flows.NetImport[0])
+	assert.True(t, math.IsNaN(flows.NetImport[1]))
+}
+
+func TestCrossBorderFlowsFetchesBordersConcurrently(t *testing.T) {
+	var mu sync.Mutex
+	inFlight, maxInFlight := 0, 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		inFlight++
+		if inFlight > maxInFlight {
+			maxInFlight = inFlight
+		}
+		mu.Unlock()
+		time.Sleep(20 * time.Millisecond)
+		mu.Lock()
+		inFlight--
+		mu.Unlock()
+		w.Write([]byte(noMatchingDataAcknowledgement))
+	}))
+	defer server.Close()
+
+	c := NewEntsoeClient("token", WithBaseURL(server.URL))
+	start := time.Date(2023, 6, 1, 10, 0, 0, 0, time.UTC)
+	_, err := c.CrossBorderFlows(context.Background(), DomainAT, start, start.Add(time.Hour), FlowKindPhysical)
+	assert.ErrorIs(t, err, ErrNoMatchingData)
+	assert.Greater(t, maxInFlight, 1)
+	assert.LessOrEqual(t, maxInFlight, DefaultBorderConcurrency)
+}
+
+func TestNeighbours(t *testing.T) {
+	assert.Equal(t, []DomainType{DomainSE4, DomainDELU, DomainDK1}, Neighbours(DomainDK2))
+	assert.Equal(t, []DomainType{DomainSE4, DomainDELU, DomainCZ, DomainLT, DomainSK, DomainUA}, Neighbours(DomainPL))
+	for _, b := range Borders {
+		assert.Contains(t, Neighbours(b.A), b.B)
+		assert.Contains(t, Neighbours(b.B), b.A)
+		_, ok := b.A.Area()
+		assert.True(t, ok, b.A)
+		_, ok = b.B.Area()
+		assert.True(t, ok, b.B)
+	}
+}
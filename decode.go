@@ -0,0 +1,153 @@
+package goentsoe
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"io/ioutil"
+)
+
+// Document is a decoded market document: one of *GLMarketDocument,
+// *PublicationMarketDocument, *BalancingMarketDocument,
+// *UnavailabilityMarketDocument, *TransmissionNetworkMarketDocument,
+// *CriticalNetworkElementMarketDocument, *ConfigurationMarketDocument,
+// *AcknowledgementMarketDocument or Documents.
+type Document interface {
+	marketDocument()
+}
+
+// Documents holds the documents of a zip archive in archive order.
+type Documents []Document
+
+func (*GLMarketDocument) marketDocument()                     {}
+func (*PublicationMarketDocument) marketDocument()            {}
+func (*BalancingMarketDocument) marketDocument()              {}
+func (*UnavailabilityMarketDocument) marketDocument()         {}
+func (*TransmissionNetworkMarketDocument) marketDocument()    {}
+func (*CriticalNetworkElementMarketDocument) marketDocument() {}
+func (*ConfigurationMarketDocument) marketDocument()          {}
+func (*AcknowledgementMarketDocument) marketDocument()        {}
+func (Documents) marketDocument()                             {}
+
+// Decode reads a document as returned by the API, either plain XML or a zip
+// archive of XML files, and unmarshals it into the type matching its root
+// element.
+func Decode(r io.Reader) (Document, error) {
+	data, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	return decode(data)
+}
+
+func decode(data []byte) (Document, error) {
+	if isZipArchive(data) {
+		files, err := readZipArchive(data)
+		if err != nil {
+			return nil, err
+		}
+		docs := make(Documents, 0, len(files))
+		for _, file := range files {
+			doc, err := decode(file)
+			if err != nil {
+				return nil, err
+			}
+			docs = append(docs, doc)
+		}
+		return docs, nil
+	}
+
+	root := rootElementName(data)
+	doc := newDocument(root)
+	if doc == nil {
+		return nil, fmt.Errorf("entsoe: unknown document type %q", root)
+	}
+	// Some documents are declared as UTF-8 but contain names in Latin-1,
+	// which encoding/xml rejects.
+	if err := xml.Unmarshal(latin1ToUTF8(data), doc); err != nil {
+		return nil, err
+	}
+	return doc, nil
+}
+
+// newDocument returns an empty document for the root element name, or nil if
+// the name is unknown.
+func newDocument(root string) Document {
+	switch root {
+	case "GL_MarketDocument":
+		return &GLMarketDocument{}
+	case "Publication_MarketDocument":
+		return &PublicationMarketDocument{}
+	case "Balancing_MarketDocument":
+		return &BalancingMarketDocument{}
+	case "Unavailability_MarketDocument":
+		return &UnavailabilityMarketDocument{}
+	case "TransmissionNetwork_MarketDocument":
+		return &TransmissionNetworkMarketDocument{}
+	case "CriticalNetworkElement_MarketDocument":
+		return &CriticalNetworkElementMarketDocument{}
+	case "Configuration_MarketDocument":
+		return &ConfigurationMarketDocument{}
+	case "Acknowledgement_MarketDocument":
+		return &AcknowledgementMarketDocument{}
+	}
+	return nil
+}
+
+func unexpectedDocumentError(doc Document) error {
+	return fmt.Errorf("entsoe: unexpected document type %T", doc)
+}
+
+// mergeableDocument is implemented by the documents of endpoints whose
+// requests are split into several windows.
+type mergeableDocument interface {
+	Document
+	// merge appends the time series of next, the document of the following
+	// window, and reports whether next was of the same type.
+	merge(next Document) bool
+}
+
+func (d *GLMarketDocument) merge(next Document) bool {
+	n, ok := next.(*GLMarketDocument)
+	if ok {
+		d.TimeSeries = append(d.TimeSeries, n.TimeSeries...)
+		d.TimePeriodTimeInterval.End = n.TimePeriodTimeInterval.End
+	}
+	return ok
+}
+
+func (d *PublicationMarketDocument) merge(next Document) bool {
+	n, ok := next.(*PublicationMarketDocument)
+	if ok {
+		d.TimeSeries = append(d.TimeSeries, n.TimeSeries...)
+		d.PeriodTimeInterval.End = n.PeriodTimeInterval.End
+	}
+	return ok
+}
+
+func (d *BalancingMarketDocument) merge(next Document) bool {
+	n, ok := next.(*BalancingMarketDocument)
+	if ok {
+		d.TimeSeries = append(d.TimeSeries, n.TimeSeries...)
+		d.PeriodTimeInterval.End = n.PeriodTimeInterval.End
+	}
+	return ok
+}
+
+func (d *TransmissionNetworkMarketDocument) merge(next Document) bool {
+	n, ok := next.(*TransmissionNetworkMarketDocument)
+	if ok {
+		d.TimeSeries = append(d.TimeSeries, n.TimeSeries...)
+		d.PeriodTimeInterval.End = n.PeriodTimeInterval.End
+	}
+	return ok
+}
+
+func (d *CriticalNetworkElementMarketDocument) merge(next Document) bool {
+	n, ok := next.(*CriticalNetworkElementMarketDocument)
+	if ok {
+		d.TimeSeries = append(d.TimeSeries, n.TimeSeries...)
+		d.TimePeriodTimeInterval.End = n.TimePeriodTimeInterval.End
+	}
+	return ok
+}
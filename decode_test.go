@@ -0,0 +1,71 @@
+package goentsoe
+
+import (
+	"archive/zip"
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecode(t *testing.T) {
+	doc, err := Decode(strings.NewReader(actualTotalLoadDocument))
+	assert.Nil(t, err)
+	gl, ok := doc.(*GLMarketDocument)
+	assert.True(t, ok)
+	assert.Equal(t, "ed7acd8a6d784b7ab2a703950", gl.MRID)
+
+	doc, err = Decode(strings.NewReader(dayAheadPricesDocument))
+	assert.Nil(t, err)
+	assert.IsType(t, &PublicationMarketDocument{}, doc)
+
+	doc, err = Decode(strings.NewReader(noMatchingDataAcknowledgement))
+	assert.Nil(t, err)
+	assert.IsType(t, &AcknowledgementMarketDocument{}, doc)
+
+	_, err = Decode(strings.NewReader("<Unknown_MarketDocument/>"))
+	assert.EqualError(t, err, `entsoe: unknown document type "Unknown_MarketDocument"`)
+}
+
+func TestDecodeZipArchive(t *testing.T) {
+	var buf bytes.Buffer
+	w := zip.NewWriter(&buf)
+	for _, name := range []string{"001", "002"} {
+		f, err := w.Create(name + ".xml")
+		assert.Nil(t, err)
+		_, err = f.Write([]byte("<Unavailability_MarketDocument><mRID>" + name + "</mRID></Unavailability_MarketDocument>"))
+		assert.Nil(t, err)
+	}
+	assert.Nil(t, w.Close())
+
+	doc, err := Decode(&buf)
+	assert.Nil(t, err)
+	docs, ok := doc.(Documents)
+	assert.True(t, ok)
+	assert.Len(t, docs, 2)
+	assert.Equal(t, "002", docs[1].(*UnavailabilityMarketDocument).MRID)
+}
+
+func TestDecodeRepairsLatin1(t *testing.T) {
+	data := []byte("<?xml version=\"1.0\" encoding=\"UTF-8\"?><Configuration_MarketDocument><TimeSeries><registeredResource.name>D\xfcrnrohr</registeredResource.name></TimeSeries></Configuration_MarketDocument>")
+
+	doc, err := Decode(bytes.NewReader(data))
+	assert.Nil(t, err)
+	assert.Equal(t, "Dürnrohr", doc.(*ConfigurationMarketDocument).TimeSeries[0].RegisteredResourceName)
+}
+
+func TestMergeDocuments(t *testing.T) {
+	first, err := Decode(strings.NewReader(actualTotalLoadDocument))
+	assert.Nil(t, err)
+	second, err := Decode(strings.NewReader(actualTotalLoadDocument))
+	assert.Nil(t, err)
+	prices, err := Decode(strings.NewReader(dayAheadPricesDocument))
+	assert.Nil(t, err)
+
+	gl := first.(*GLMarketDocument)
+	assert.True(t, gl.merge(second))
+	assert.Len(t, gl.TimeSeries, 2)
+	assert.False(t, gl.merge(prices))
+	assert.Len(t, gl.TimeSeries, 2)
+}
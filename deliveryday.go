@@ -0,0 +1,175 @@
+package goentsoe
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// Date is a civil date, e.g. the delivery day of a market, independent of
+// any time zone.
+type Date struct {
+	Year  int
+	Month time.Month
+	Day   int
+}
+
+// DateOf returns the date of t in the location of t.
+func DateOf(t time.Time) Date {
+	year, month, day := t.Date()
+	return Date{year, month, day}
+}
+
+// ParseDate parses a date in the form 2006-01-02.
+func ParseDate(s string) (Date, error) {
+	t, err := time.Parse("2006-01-02", s)
+	if err != nil {
+		return Date{}, err
+	}
+	return DateOf(t), nil
+}
+
+func (d Date) String() string {
+	return fmt.Sprintf("%04d-%02d-%02d", d.Year, d.Month, d.Day)
+}
+
+// AddDays returns the date n days after d.
+func (d Date) AddDays(n int) Date {
+	return DateOf(time.Date(d.Year, d.Month, d.Day+n, 0, 0, 0, 0, time.UTC))
+}
+
+// In returns the interval [start, end) of the date in loc, in UTC. Across a
+// daylight saving time switch the interval is 23 or 25 hours long.
+func (d Date) In(loc *time.Location) (start, end time.Time) {
+	start = time.Date(d.Year, d.Month, d.Day, 0, 0, 0, 0, loc)
+	end = time.Date(d.Year, d.Month, d.Day+1, 0, 0, 0, 0, loc)
+	return start.UTC(), end.UTC()
+}
+
+// DeliveryDay returns the interval [start, end) of the delivery day d in the
+// local time of the area, in UTC.
+func (a Area) DeliveryDay(d Date) (start, end time.Time, err error) {
+	loc, err := a.Location()
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+	start, end = d.In(loc)
+	return start, end, nil
+}
+
+// DeliveryDay returns the interval [start, end) of the delivery day d in the
+// local time of zone, in UTC. It returns ErrUnknownArea if zone is not in the
+// area registry.
+func DeliveryDay(zone DomainType, d Date) (start, end time.Time, err error) {
+	a, ok := LookupArea(zone)
+	if !ok {
+		return time.Time{}, time.Time{}, fmt.Errorf("%w: %s", ErrUnknownArea, zone)
+	}
+	return a.DeliveryDay(d)
+}
+
+// AreaLocation returns the time zone of zone. It returns ErrUnknownArea if
+// zone is not in the area registry.
+func AreaLocation(zone DomainType) (*time.Location, error) {
+	a, ok := LookupArea(zone)
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownArea, zone)
+	}
+	return a.Location()
+}
+
+// In returns a copy of ts with the start and end of every point, as well as
+// the missing times, in loc.
+func (ts TimeSeries) In(loc *time.Location) TimeSeries {
+	points := make([]Point, len(ts.Points))
+	for i, p := range ts.Points {
+		p.Start = p.Start.In(loc)
+		p.End = p.End.In(loc)
+		points[i] = p
+	}
+	ts.Points = points
+	if ts.Missing != nil {
+		missing := make([]time.Time, len(ts.Missing))
+		for i, t := range ts.Missing {
+			missing[i] = t.In(loc)
+		}
+		ts.Missing = missing
+	}
+	return ts
+}
+
+// LocalTimeSeries returns copies of series with all times in the local time
+// of zone.
+func LocalTimeSeries(zone DomainType, series []TimeSeries) ([]TimeSeries, error) {
+	loc, err := AreaLocation(zone)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]TimeSeries, len(series))
+	for i, ts := range series {
+		res[i] = ts.In(loc)
+	}
+	return res, nil
+}
+
+// GetActualTotalLoadForDate is GetActualTotalLoad for the delivery day date
+// of domain.
+func (c *EntsoeClient) GetActualTotalLoadForDate(domain DomainType, date Date) (*GLMarketDocument, error) {
+	return c.GetActualTotalLoadForDateCtx(context.Background(), domain, date)
+}
+
+// GetActualTotalLoadForDateCtx is GetActualTotalLoadForDate with a context.
+func (c *EntsoeClient) GetActualTotalLoadForDateCtx(ctx context.Context, domain DomainType, date Date) (*GLMarketDocument, error) {
+	start, end, err := DeliveryDay(domain, date)
+	if err != nil {
+		return nil, err
+	}
+	return c.GetActualTotalLoadCtx(ctx, domain, start, end)
+}
+
+// GetDayAheadTotalLoadForecastForDate is GetDayAheadTotalLoadForecast for the
+// delivery day date of domain.
+func (c *EntsoeClient) GetDayAheadTotalLoadForecastForDate(domain DomainType, date Date) (*GLMarketDocument, error) {
+	return c.GetDayAheadTotalLoadForecastForDateCtx(context.Background(), domain, date)
+}
+
+// GetDayAheadTotalLoadForecastForDateCtx is GetDayAheadTotalLoadForecastForDate
+// with a context.
+func (c *EntsoeClient) GetDayAheadTotalLoadForecastForDateCtx(ctx context.Context, domain DomainType, date Date) (*GLMarketDocument, error) {
+	start, end, err := DeliveryDay(domain, date)
+	if err != nil {
+		return nil, err
+	}
+	return c.GetDayAheadTotalLoadForecastCtx(ctx, domain, start, end)
+}
+
+// GetDayAheadPricesForDate is GetDayAheadPrices for the delivery day date of
+// domain.
+func (c *EntsoeClient) GetDayAheadPricesForDate(domain DomainType, date Date) (*PublicationMarketDocument, error) {
+	return c.GetDayAheadPricesForDateCtx(context.Background(), domain, date)
+}
+
+// GetDayAheadPricesForDateCtx is GetDayAheadPricesForDate with a context.
+func (c *EntsoeClient) GetDayAheadPricesForDateCtx(ctx context.Context, domain DomainType, date Date) (*PublicationMarketDocument, error) {
+	start, end, err := DeliveryDay(domain, date)
+	if err != nil {
+		return nil, err
+	}
+	return c.GetDayAheadPricesCtx(ctx, domain, start, end)
+}
+
+// GetAggregatedGenerationPerTypeForDate is GetAggregatedGenerationPerType for
+// the delivery day date of inDomain.
+func (c *EntsoeClient) GetAggregatedGenerationPerTypeForDate(processType ProcessType, psrType PsrType, inDomain DomainType, date Date) (*GLMarketDocument, error) {
+	return c.GetAggregatedGenerationPerTypeForDateCtx(context.Background(), processType, psrType, inDomain, date)
+}
+
+// GetAggregatedGenerationPerTypeForDateCtx is
+// GetAggregatedGenerationPerTypeForDate with a context.
+func (c *EntsoeClient) GetAggregatedGenerationPerTypeForDateCtx(ctx context.Context, processType ProcessType, psrType PsrType, inDomain DomainType, date Date) (*GLMarketDocument, error) {
+	start, end, err := DeliveryDay(inDomain, date)
+	if err != nil {
+		return nil, err
+	}
+	return c.GetAggregatedGenerationPerTypeCtx(ctx, processType, psrType, inDomain, start, end)
+}
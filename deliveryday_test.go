@@ -0,0 +1,73 @@
+package goentsoe
+
+import (
+	"encoding/xml"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeliveryDay(t *testing.T) {
+	for _, tc := range []struct {
+		date  Date
+		start time.Time
+		hours float64
+	}{
+		{Date{2024, time.March, 30}, time.Date(2024, 3, 29, 23, 0, 0, 0, time.UTC), 24},
+		{Date{2024, time.March, 31}, time.Date(2024, 3, 30, 23, 0, 0, 0, time.UTC), 23},
+		{Date{2024, time.October, 27}, time.Date(2024, 10, 26, 22, 0, 0, 0, time.UTC), 25},
+	} {
+		start, end, err := DeliveryDay(DomainDELU, tc.date)
+		assert.Nil(t, err)
+		assert.Equal(t, tc.start, start, tc.date)
+		assert.Equal(t, tc.hours, end.Sub(start).Hours(), tc.date)
+	}
+
+	start, _, err := DeliveryDay(DomainGB, Date{2024, time.July, 1})
+	assert.Nil(t, err)
+	assert.Equal(t, time.Date(2024, 6, 30, 23, 0, 0, 0, time.UTC), start)
+
+	_, _, err = DeliveryDay("10YXX-UNKNOWN--0", Date{2024, time.July, 1})
+	assert.True(t, errors.Is(err, ErrUnknownArea))
+}
+
+func TestDate(t *testing.T) {
+	d, err := ParseDate("2024-02-28")
+	assert.Nil(t, err)
+	assert.Equal(t, Date{2024, time.February, 28}, d)
+	assert.Equal(t, "2024-03-01", d.AddDays(2).String())
+
+	_, err = ParseDate("28.02.2024")
+	assert.NotNil(t, err)
+}
+
+func TestLocalTimeSeries(t *testing.T) {
+	var doc GLMarketDocument
+	assert.Nil(t, xml.Unmarshal([]byte(actualTotalLoadDocument), &doc))
+	series, err := ConvertGLMarketDocument(&doc)
+	assert.Nil(t, err)
+
+	local, err := LocalTimeSeries(DomainCZ, series)
+	assert.Nil(t, err)
+	assert.Equal(t, "2016-01-01T00:00:00+01:00", local[0].Points[0].Start.Format(time.RFC3339))
+	assert.True(t, local[0].Points[0].Start.Equal(series[0].Points[0].Start))
+	assert.Equal(t, time.UTC, series[0].Points[0].Start.Location())
+}
+
+func TestGetDayAheadPricesForDate(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "202403302300", r.URL.Query().Get(ParameterPeriodStart))
+		assert.Equal(t, "202403312200", r.URL.Query().Get(ParameterPeriodEnd))
+		w.Write([]byte(dayAheadPricesDocument))
+	}))
+	defer server.Close()
+
+	c := NewEntsoeClient("token", WithBaseURL(server.URL))
+	doc, err := c.GetDayAheadPricesForDate(DomainDELU, Date{2024, time.March, 31})
+	assert.Nil(t, err)
+	assert.NotNil(t, doc)
+}
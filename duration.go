@@ -0,0 +1,135 @@
+package goentsoe
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Duration is an ISO 8601 duration such as PT15M, P1D or P1M, as used for
+// the resolution of a Period. Calendar components are kept separate from the
+// clock time so that adding P1M or P1Y follows the calendar.
+type Duration struct {
+	Years  int
+	Months int
+	Days   int
+	// Time is the sum of the hour, minute and second components.
+	Time time.Duration
+}
+
+// ParseDuration parses an ISO 8601 duration of the form
+// P[nY][nM][nW][nD][T[nH][nM][nS]]. Only the seconds may have a fraction.
+func ParseDuration(s string) (Duration, error) {
+	var d Duration
+	rest := s
+	if !strings.HasPrefix(rest, "P") || len(rest) < 2 {
+		return d, fmt.Errorf("invalid ISO 8601 duration %q", s)
+	}
+	rest = rest[1:]
+
+	inTime := false
+	components := 0
+	for len(rest) > 0 {
+		if rest[0] == 'T' {
+			if inTime || len(rest) == 1 {
+				return d, fmt.Errorf("invalid ISO 8601 duration %q", s)
+			}
+			inTime = true
+			rest = rest[1:]
+			continue
+		}
+		i := strings.IndexFunc(rest, func(r rune) bool {
+			return (r < '0' || r > '9') && r != '.'
+		})
+		if i <= 0 {
+			return d, fmt.Errorf("invalid ISO 8601 duration %q", s)
+		}
+		number, designator := rest[:i], rest[i]
+		rest = rest[i+1:]
+		components++
+
+		if designator == 'S' && inTime {
+			seconds, err := strconv.ParseFloat(number, 64)
+			if err != nil {
+				return d, fmt.Errorf("invalid ISO 8601 duration %q: %w", s, err)
+			}
+			d.Time += time.Duration(seconds * float64(time.Second))
+			continue
+		}
+		n, err := strconv.Atoi(number)
+		if err != nil {
+			return d, fmt.Errorf("invalid ISO 8601 duration %q: %w", s, err)
+		}
+		switch {
+		case designator == 'Y' && !inTime:
+			d.Years += n
+		case designator == 'M' && !inTime:
+			d.Months += n
+		case designator == 'W' && !inTime:
+			d.Days += 7 * n
+		case designator == 'D' && !inTime:
+			d.Days += n
+		case designator == 'H' && inTime:
+			d.Time += time.Duration(n) * time.Hour
+		case designator == 'M' && inTime:
+			d.Time += time.Duration(n) * time.Minute
+		default:
+			return d, fmt.Errorf("invalid ISO 8601 duration %q", s)
+		}
+	}
+	if components == 0 {
+		return d, fmt.Errorf("invalid ISO 8601 duration %q", s)
+	}
+	return d, nil
+}
+
+// IsZero reports whether d has no length.
+func (d Duration) IsZero() bool {
+	return d.Years == 0 && d.Months == 0 && d.Days == 0 && d.Time == 0
+}
+
+// AddTo returns t advanced by n times d. Calendar components follow the
+// wall clock in the location of t, so P1D steps from midnight to midnight
+// across daylight saving time changes. They are applied as a whole from t and
+// clamped to the end of the month, so the n-th month after January 31 is the
+// last day of that month.
+func (d Duration) AddTo(t time.Time, n int) time.Time {
+	if months := n * (12*d.Years + d.Months); months != 0 {
+		year, month, day := t.Date()
+		hour, min, sec := t.Clock()
+		year, month, _ = time.Date(year, month+time.Month(months), 1, 0, 0, 0, 0, time.UTC).Date()
+		if last := time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC).Day(); day > last {
+			day = last
+		}
+		t = time.Date(year, month, day, hour, min, sec, t.Nanosecond(), t.Location())
+	}
+	if d.Days != 0 {
+		t = t.AddDate(0, 0, n*d.Days)
+	}
+	return t.Add(time.Duration(n) * d.Time)
+}
+
+// PointTime returns the start of the point at position (counting from 1) in
+// a period starting at start with the given ISO 8601 resolution. Periods
+// start at midnight local time of their area, so for calendar resolutions
+// start must be in that location; see AddTo.
+func PointTime(start time.Time, resolution string, position int) (time.Time, error) {
+	d, err := parseResolution(resolution)
+	if err != nil {
+		return start, err
+	}
+	return d.AddTo(start, position-1), nil
+}
+
+// parseResolution parses the resolution of a Period, which must not be zero.
+func parseResolution(resolution string) (Duration, error) {
+	d, err := ParseDuration(resolution)
+	if err != nil {
+		return d, err
+	}
+	if d.IsZero() {
+		return d, fmt.Errorf("resolution %q has no length", resolution)
+	}
+	return d, nil
+}
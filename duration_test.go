@@ -0,0 +1,69 @@
+package goentsoe
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseDuration(t *testing.T) {
+	for s, expected := range map[string]Duration{
+		"PT1M":       {Time: time.Minute},
+		"PT15M":      {Time: 15 * time.Minute},
+		"PT60M":      {Time: time.Hour},
+		"PT1H30M":    {Time: 90 * time.Minute},
+		"PT0.5S":     {Time: 500 * time.Millisecond},
+		"P1D":        {Days: 1},
+		"P1W":        {Days: 7},
+		"P1M":        {Months: 1},
+		"P1Y":        {Years: 1},
+		"P1Y2M3DT4H": {Years: 1, Months: 2, Days: 3, Time: 4 * time.Hour},
+	} {
+		d, err := ParseDuration(s)
+		assert.Nil(t, err, s)
+		assert.Equal(t, expected, d, s)
+	}
+
+	for _, s := range []string{"", "P", "PT", "15M", "PT15", "P1H", "PT1D", "P1.5D", "P1DT"} {
+		_, err := ParseDuration(s)
+		assert.NotNil(t, err, s)
+	}
+}
+
+func TestPointTime(t *testing.T) {
+	start := time.Date(2016, 1, 31, 0, 0, 0, 0, time.UTC)
+
+	pointTime, err := PointTime(start, "P1M", 2)
+	assert.Nil(t, err)
+	assert.Equal(t, time.Date(2016, 2, 29, 0, 0, 0, 0, time.UTC), pointTime)
+	pointTime, err = PointTime(start, "P1M", 3)
+	assert.Nil(t, err)
+	assert.Equal(t, time.Date(2016, 3, 31, 0, 0, 0, 0, time.UTC), pointTime)
+
+	pointTime, err = PointTime(start, "PT1M", 61)
+	assert.Nil(t, err)
+	assert.Equal(t, time.Date(2016, 1, 31, 1, 0, 0, 0, time.UTC), pointTime)
+
+	_, err = PointTime(start, "PT0M", 2)
+	assert.NotNil(t, err)
+	_, err = PointTime(start, "XYZ", 2)
+	assert.NotNil(t, err)
+}
+
+func TestPointTimeLocal(t *testing.T) {
+	loc, err := time.LoadLocation("Europe/Prague")
+	assert.Nil(t, err)
+
+	// Periods start at midnight local time.
+	start := time.Date(2015, 12, 31, 23, 0, 0, 0, time.UTC).In(loc)
+	pointTime, err := PointTime(start, "P1M", 3)
+	assert.Nil(t, err)
+	assert.Equal(t, time.Date(2016, 2, 29, 23, 0, 0, 0, time.UTC), pointTime.UTC())
+
+	// The day of the switch to summer time has 23 hours.
+	start = time.Date(2016, 3, 25, 23, 0, 0, 0, time.UTC).In(loc)
+	pointTime, err = PointTime(start, "P1D", 4)
+	assert.Nil(t, err)
+	assert.Equal(t, time.Date(2016, 3, 28, 22, 0, 0, 0, time.UTC), pointTime.UTC())
+}
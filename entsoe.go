@@ -3,16 +3,22 @@
 package goentsoe
 
 import (
-	"encoding/xml"
+	"archive/zip"
+	"bytes"
+	"context"
+	"errors"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"log"
+	"math"
 	"net/http"
 	"net/url"
 	"os"
 	"sort"
 	"strconv"
 	"time"
+	"unicode/utf8"
 )
 
 type PsrType string
@@ -153,77 +159,147 @@ const (
 	DocumentTypeFlowBasedAllocations                     DocumentType = "B11"
 )
 
-type DomainType = string
+type CurveType string
 
 const (
-	DomainAL           DomainType = "10YAL-KESH-----5"
-	DomainAT           DomainType = "10YAT-APG------L"
-	DomainBA           DomainType = "10YBA-JPCC-----D"
-	DomainBE           DomainType = "10YBE----------2"
-	DomainBG           DomainType = "10YCA-BULGARIA-R"
-	DomainBY           DomainType = "10Y1001A1001A51S"
-	DomainCH           DomainType = "10YCH-SWISSGRIDZ"
-	DomainCZ           DomainType = "10YCZ-CEPS-----N"
-	DomainDE           DomainType = "10Y1001A1001A83F"
-	DomainDE50Hertz    DomainType = "10YDE-VE-------2"
-	DomainDEAmprion    DomainType = "10YDE-RWENET---I"
-	DomainDETenneT     DomainType = "10YDE-EON------1"
-	DomainDETransnetBW DomainType = "10YDE-ENBW-----N"
-	DomainDK           DomainType = "10Y1001A1001A65H"
-	DomainEE           DomainType = "10Y1001A1001A39I"
-	DomainES           DomainType = "10YES-REE------0"
-	DomainFI           DomainType = "10YFI-1--------U"
-	DomainFR           DomainType = "10YFR-RTE------C"
-	DomainGB           DomainType = "10YGB----------A"
-	DomainGBNIR        DomainType = "10Y1001A1001A016"
-	DomainGR           DomainType = "10YGR-HTSO-----Y"
-	DomainHR           DomainType = "10YHR-HEP------M"
-	DomainHU           DomainType = "10YHU-MAVIR----U"
-	DomainIE           DomainType = "10YIE-1001A00010"
-	DomainIT           DomainType = "10YIT-GRTN-----B"
-	DomainLT           DomainType = "10YLT-1001A0008Q"
-	DomainLU           DomainType = "10YLU-CEGEDEL-NQ"
-	DomainLV           DomainType = "10YLV-1001A00074"
-	DomainME           DomainType = "10YCS-CG-TSO---S"
-	DomainMK           DomainType = "10YMK-MEPSO----8"
-	DomainMT           DomainType = "10Y1001A1001A93C"
-	DomainNL           DomainType = "10YNL----------L"
-	DomainNO           DomainType = "10YNO-0--------C"
-	DomainPL           DomainType = "10YPL-AREA-----S"
-	DomainPT           DomainType = "10YPT-REN------W"
-	DomainRO           DomainType = "10YRO-TEL------P"
-	DomainRS           DomainType = "10YCS-SERBIATSOV"
-	DomainRU           DomainType = "10Y1001A1001A49F"
-	DomainRUKGD        DomainType = "10Y1001A1001A50U"
-	DomainSE           DomainType = "10YSE-1--------K"
-	DomainSI           DomainType = "10YSI-ELES-----O"
-	DomainSK           DomainType = "10YSK-SEPS-----K"
-	DomainTR           DomainType = "10YTR-TEIAS----W"
-	DomainUA           DomainType = "10YUA-WEPS-----0"
-	DomainDEATLU       DomainType = "10Y1001A1001A63L"
+	CurveTypeSequentialFixedSizeBlock CurveType = "A01"
+	CurveTypeVariableSizedBlock       CurveType = "A03"
 )
 
+// DomainType is the EIC code of an area. See LookupArea for the registry of
+// known areas.
+type DomainType string
+
+const (
+	DomainAL            DomainType = "10YAL-KESH-----5"
+	DomainAT            DomainType = "10YAT-APG------L"
+	DomainBA            DomainType = "10YBA-JPCC-----D"
+	DomainBE            DomainType = "10YBE----------2"
+	DomainBG            DomainType = "10YCA-BULGARIA-R"
+	DomainBY            DomainType = "10Y1001A1001A51S"
+	DomainCH            DomainType = "10YCH-SWISSGRIDZ"
+	DomainCZ            DomainType = "10YCZ-CEPS-----N"
+	DomainDE            DomainType = "10Y1001A1001A83F"
+	DomainDE50Hertz     DomainType = "10YDE-VE-------2"
+	DomainDEAmprion     DomainType = "10YDE-RWENET---I"
+	DomainDETenneT      DomainType = "10YDE-EON------1"
+	DomainDETransnetBW  DomainType = "10YDE-ENBW-----N"
+	DomainDK            DomainType = "10Y1001A1001A65H"
+	DomainEE            DomainType = "10Y1001A1001A39I"
+	DomainES            DomainType = "10YES-REE------0"
+	DomainFI            DomainType = "10YFI-1--------U"
+	DomainFR            DomainType = "10YFR-RTE------C"
+	DomainGB            DomainType = "10YGB----------A"
+	DomainGBNIR         DomainType = "10Y1001A1001A016"
+	DomainGR            DomainType = "10YGR-HTSO-----Y"
+	DomainHR            DomainType = "10YHR-HEP------M"
+	DomainHU            DomainType = "10YHU-MAVIR----U"
+	DomainIE            DomainType = "10YIE-1001A00010"
+	DomainIT            DomainType = "10YIT-GRTN-----B"
+	DomainLT            DomainType = "10YLT-1001A0008Q"
+	DomainLU            DomainType = "10YLU-CEGEDEL-NQ"
+	DomainLV            DomainType = "10YLV-1001A00074"
+	DomainME            DomainType = "10YCS-CG-TSO---S"
+	DomainMK            DomainType = "10YMK-MEPSO----8"
+	DomainMT            DomainType = "10Y1001A1001A93C"
+	DomainNL            DomainType = "10YNL----------L"
+	DomainNO            DomainType = "10YNO-0--------C"
+	DomainPL            DomainType = "10YPL-AREA-----S"
+	DomainPT            DomainType = "10YPT-REN------W"
+	DomainRO            DomainType = "10YRO-TEL------P"
+	DomainRS            DomainType = "10YCS-SERBIATSOV"
+	DomainRU            DomainType = "10Y1001A1001A49F"
+	DomainRUKGD         DomainType = "10Y1001A1001A50U"
+	DomainSE            DomainType = "10YSE-1--------K"
+	DomainSI            DomainType = "10YSI-ELES-----O"
+	DomainSK            DomainType = "10YSK-SEPS-----K"
+	DomainTR            DomainType = "10YTR-TEIAS----W"
+	DomainUA            DomainType = "10YUA-WEPS-----0"
+	DomainDEATLU        DomainType = "10Y1001A1001A63L"
+	DomainDELU          DomainType = "10Y1001A1001A82H"
+	DomainCY            DomainType = "10YCY-1001A0003J"
+	DomainMD            DomainType = "10Y1001A1001A990"
+	DomainXK            DomainType = "10Y1001C--00100H"
+	DomainIESEM         DomainType = "10Y1001A1001A59C"
+	DomainDK1           DomainType = "10YDK-1--------W"
+	DomainDK2           DomainType = "10YDK-2--------M"
+	DomainNO1           DomainType = "10YNO-1--------2"
+	DomainNO2           DomainType = "10YNO-2--------T"
+	DomainNO3           DomainType = "10YNO-3--------J"
+	DomainNO4           DomainType = "10YNO-4--------9"
+	DomainNO5           DomainType = "10Y1001A1001A48H"
+	DomainSE1           DomainType = "10Y1001A1001A44P"
+	DomainSE2           DomainType = "10Y1001A1001A45N"
+	DomainSE3           DomainType = "10Y1001A1001A46L"
+	DomainSE4           DomainType = "10Y1001A1001A47J"
+	DomainITNorth       DomainType = "10Y1001A1001A73I"
+	DomainITCentreNorth DomainType = "10Y1001A1001A70O"
+	DomainITCentreSouth DomainType = "10Y1001A1001A71M"
+	DomainITSouth       DomainType = "10Y1001A1001A788"
+	DomainITCalabria    DomainType = "10Y1001C--00096J"
+	DomainITSardinia    DomainType = "10Y1001A1001A74G"
+	DomainITSicily      DomainType = "10Y1001A1001A75E"
+	// DomainCWE is the flow based study domain of the Central Western Europe
+	// region.
+	DomainCWE DomainType = "10YDOM-REGION-1V"
+)
+
+// DefaultBaseURL is the API endpoint used unless WithBaseURL is given.
+const DefaultBaseURL = "https://transparency.entsoe.eu/api"
+
 type EntsoeClient struct {
-	apiKey string
+	apiKey      string
+	baseURL     string
+	httpClient  *http.Client
+	userAgent   string
+	timeout     time.Duration
+	retryPolicy RetryPolicy
+	rateLimiter *RateLimiter
+	logger      Logger
+
+	disablePeriodSplitting bool
+	concurrency            int
+	borderConcurrency      int
 }
 
-func NewEntsoeClient(apiKey string) *EntsoeClient {
+func NewEntsoeClient(apiKey string, opts ...Option) *EntsoeClient {
 	c := EntsoeClient{
-		apiKey: apiKey,
+		apiKey:      apiKey,
+		baseURL:     DefaultBaseURL,
+		httpClient:  http.DefaultClient,
+		retryPolicy: DefaultRetryPolicy(),
+		rateLimiter: NewRateLimiter(DefaultRateLimitRequests, time.Minute, DefaultRateLimitBurst),
+		logger:      nopLogger{},
+
+		borderConcurrency: DefaultBorderConcurrency,
+	}
+	for _, opt := range opts {
+		opt(&c)
 	}
 	return &c
 }
 
-func NewEntsoeClientFromEnv() *EntsoeClient {
+// NewEntsoeClientFromEnv creates a client with the api key from the
+// ENTSOE_API_KEY environment variable. It returns ErrMissingAPIKey if the
+// variable is not set.
+func NewEntsoeClientFromEnv(opts ...Option) (*EntsoeClient, error) {
 	apiKey := os.Getenv("ENTSOE_API_KEY")
 	if apiKey == "" {
-		log.Fatal("Environment variable ENTSOE_API_KEY with api key not set")
+		return nil, ErrMissingAPIKey
 	}
+	return NewEntsoeClient(apiKey, opts...), nil
+}
 
-	c := EntsoeClient{
-		apiKey: apiKey,
+// MustNewEntsoeClientFromEnv is like NewEntsoeClientFromEnv but exits the
+// program if the api key is not set.
+//
+// Deprecated: Use NewEntsoeClientFromEnv.
+func MustNewEntsoeClientFromEnv(opts ...Option) *EntsoeClient {
+	c, err := NewEntsoeClientFromEnv(opts...)
+	if err != nil {
+		log.Fatal(err)
 	}
-	return &c
+	return c
 }
 
 type Parameter string
@@ -244,6 +320,7 @@ const (
 	ParameterControlAreaDomain                                        = "controlArea_Domain"
 	ParameterInDomain                                                 = "in_Domain"
 	ParameterOutDomain                                                = "out_Domain"
+	ParameterAreaDomain                                               = "area_Domain"
 	ParameterAcquiringDomain                                          = "acquiring_Domain"
 	ParameterConnectingDomain                                         = "connecting_Domain"
 	ParameterRegisteredResource                                       = "RegisteredResource"
@@ -253,6 +330,8 @@ const (
 	ParameterTimeIntervalUpdate                                       = "TimeIntervalUpdate"
 	ParameterPeriodStartUpdate                                        = "PeriodStartUpdate"
 	ParameterPeriodEndUpdate                                          = "PeriodEndUpdate"
+	ParameterOffset                                                   = "offset"
+	ParameterImplementationDateAndOrTime                              = "implementation_DateAndOrTime"
 )
 
 type ContractMarketAgreementType string
@@ -291,6 +370,16 @@ func (c *EntsoeClient) GetActualTotalLoad(
 	domain DomainType,
 	periodStart time.Time,
 	periodEnd time.Time,
+) (*GLMarketDocument, error) {
+	return c.GetActualTotalLoadCtx(context.Background(), domain, periodStart, periodEnd)
+}
+
+// GetActualTotalLoadCtx is GetActualTotalLoad with a context.
+func (c *EntsoeClient) GetActualTotalLoadCtx(
+	ctx context.Context,
+	domain DomainType,
+	periodStart time.Time,
+	periodEnd time.Time,
 ) (*GLMarketDocument, error) {
 	params := url.Values{}
 	params.Add(ParameterDocumentType, string(DocumentTypeSystemTotalLoad))
@@ -298,7 +387,7 @@ func (c *EntsoeClient) GetActualTotalLoad(
 	params.Add(ParameterOutBiddingZoneDomain, string(domain))
 	params.Add(ParameterPeriodStart, periodStart.UTC().Format("200601021504"))
 	params.Add(ParameterPeriodEnd, periodEnd.UTC().Format("200601021504"))
-	return c.requestGLMarketDocument(params)
+	return c.requestGLMarketDocument(ctx, params)
 }
 
 // 4.1.2. Day-Ahead Total Load Forecast [6.1.B]
@@ -306,6 +395,16 @@ func (c *EntsoeClient) GetDayAheadTotalLoadForecast(
 	domain DomainType,
 	periodStart time.Time,
 	periodEnd time.Time,
+) (*GLMarketDocument, error) {
+	return c.GetDayAheadTotalLoadForecastCtx(context.Background(), domain, periodStart, periodEnd)
+}
+
+// GetDayAheadTotalLoadForecastCtx is GetDayAheadTotalLoadForecast with a context.
+func (c *EntsoeClient) GetDayAheadTotalLoadForecastCtx(
+	ctx context.Context,
+	domain DomainType,
+	periodStart time.Time,
+	periodEnd time.Time,
 ) (*GLMarketDocument, error) {
 	params := url.Values{}
 	params.Add(ParameterDocumentType, string(DocumentTypeSystemTotalLoad))
@@ -313,7 +412,7 @@ func (c *EntsoeClient) GetDayAheadTotalLoadForecast(
 	params.Add(ParameterOutBiddingZoneDomain, string(domain))
 	params.Add(ParameterPeriodStart, periodStart.UTC().Format("200601021504"))
 	params.Add(ParameterPeriodEnd, periodEnd.UTC().Format("200601021504"))
-	return c.requestGLMarketDocument(params)
+	return c.requestGLMarketDocument(ctx, params)
 }
 
 // 4.1.3. Week-Ahead Total Load Forecast [6.1.C]
@@ -321,6 +420,16 @@ func (c *EntsoeClient) GetWeekAheadTotalLoadForecast(
 	domain DomainType,
 	periodStart time.Time,
 	periodEnd time.Time,
+) (*GLMarketDocument, error) {
+	return c.GetWeekAheadTotalLoadForecastCtx(context.Background(), domain, periodStart, periodEnd)
+}
+
+// GetWeekAheadTotalLoadForecastCtx is GetWeekAheadTotalLoadForecast with a context.
+func (c *EntsoeClient) GetWeekAheadTotalLoadForecastCtx(
+	ctx context.Context,
+	domain DomainType,
+	periodStart time.Time,
+	periodEnd time.Time,
 ) (*GLMarketDocument, error) {
 	params := url.Values{}
 	params.Add(ParameterDocumentType, string(DocumentTypeSystemTotalLoad))
@@ -328,7 +437,7 @@ func (c *EntsoeClient) GetWeekAheadTotalLoadForecast(
 	params.Add(ParameterOutBiddingZoneDomain, string(domain))
 	params.Add(ParameterPeriodStart, periodStart.UTC().Format("200601021504"))
 	params.Add(ParameterPeriodEnd, periodEnd.UTC().Format("200601021504"))
-	return c.requestGLMarketDocument(params)
+	return c.requestGLMarketDocument(ctx, params)
 }
 
 // 4.1.4. Month-Ahead Total Load Forecast [6.1.D]
@@ -336,6 +445,16 @@ func (c *EntsoeClient) GetMonthAheadTotalLoadForecast(
 	domain DomainType,
 	periodStart time.Time,
 	periodEnd time.Time,
+) (*GLMarketDocument, error) {
+	return c.GetMonthAheadTotalLoadForecastCtx(context.Background(), domain, periodStart, periodEnd)
+}
+
+// GetMonthAheadTotalLoadForecastCtx is GetMonthAheadTotalLoadForecast with a context.
+func (c *EntsoeClient) GetMonthAheadTotalLoadForecastCtx(
+	ctx context.Context,
+	domain DomainType,
+	periodStart time.Time,
+	periodEnd time.Time,
 ) (*GLMarketDocument, error) {
 	params := url.Values{}
 	params.Add(ParameterDocumentType, string(DocumentTypeSystemTotalLoad))
@@ -343,7 +462,7 @@ func (c *EntsoeClient) GetMonthAheadTotalLoadForecast(
 	params.Add(ParameterOutBiddingZoneDomain, string(domain))
 	params.Add(ParameterPeriodStart, periodStart.UTC().Format("200601021504"))
 	params.Add(ParameterPeriodEnd, periodEnd.UTC().Format("200601021504"))
-	return c.requestGLMarketDocument(params)
+	return c.requestGLMarketDocument(ctx, params)
 }
 
 // 4.1.5. Year-Ahead Total Load Forecast [6.1.E]
@@ -351,6 +470,16 @@ func (c *EntsoeClient) GetYearAheadTotalLoadForecast(
 	domain DomainType,
 	periodStart time.Time,
 	periodEnd time.Time,
+) (*GLMarketDocument, error) {
+	return c.GetYearAheadTotalLoadForecastCtx(context.Background(), domain, periodStart, periodEnd)
+}
+
+// GetYearAheadTotalLoadForecastCtx is GetYearAheadTotalLoadForecast with a context.
+func (c *EntsoeClient) GetYearAheadTotalLoadForecastCtx(
+	ctx context.Context,
+	domain DomainType,
+	periodStart time.Time,
+	periodEnd time.Time,
 ) (*GLMarketDocument, error) {
 	params := url.Values{}
 	params.Add(ParameterDocumentType, string(DocumentTypeSystemTotalLoad))
@@ -358,7 +487,7 @@ func (c *EntsoeClient) GetYearAheadTotalLoadForecast(
 	params.Add(ParameterOutBiddingZoneDomain, string(domain))
 	params.Add(ParameterPeriodStart, periodStart.UTC().Format("200601021504"))
 	params.Add(ParameterPeriodEnd, periodEnd.UTC().Format("200601021504"))
-	return c.requestGLMarketDocument(params)
+	return c.requestGLMarketDocument(ctx, params)
 }
 
 // 4.1.6. Year-Ahead Forecast Margin [8.1]
@@ -366,6 +495,16 @@ func (c *EntsoeClient) GetYearAheadForecastMargin(
 	domain DomainType,
 	periodStart time.Time,
 	periodEnd time.Time,
+) (*GLMarketDocument, error) {
+	return c.GetYearAheadForecastMarginCtx(context.Background(), domain, periodStart, periodEnd)
+}
+
+// GetYearAheadForecastMarginCtx is GetYearAheadForecastMargin with a context.
+func (c *EntsoeClient) GetYearAheadForecastMarginCtx(
+	ctx context.Context,
+	domain DomainType,
+	periodStart time.Time,
+	periodEnd time.Time,
 ) (*GLMarketDocument, error) {
 	params := url.Values{}
 	params.Add(ParameterDocumentType, string(DocumentTypeLoadForecastMargin))
@@ -373,7 +512,7 @@ func (c *EntsoeClient) GetYearAheadForecastMargin(
 	params.Add(ParameterOutBiddingZoneDomain, string(domain))
 	params.Add(ParameterPeriodStart, periodStart.UTC().Format("200601021504"))
 	params.Add(ParameterPeriodEnd, periodEnd.UTC().Format("200601021504"))
-	return c.requestGLMarketDocument(params)
+	return c.requestGLMarketDocument(ctx, params)
 }
 
 // 4.2. Transmission domain
@@ -386,6 +525,19 @@ func (c *EntsoeClient) GetExpansionAndDismantlingProjects(
 	periodEnd time.Time,
 	business *BusinessType,
 	docStatus *DocStatus,
+) (*TransmissionNetworkMarketDocument, error) {
+	return c.GetExpansionAndDismantlingProjectsCtx(context.Background(), inDomain, outDomain, periodStart, periodEnd, business, docStatus)
+}
+
+// GetExpansionAndDismantlingProjectsCtx is GetExpansionAndDismantlingProjects with a context.
+func (c *EntsoeClient) GetExpansionAndDismantlingProjectsCtx(
+	ctx context.Context,
+	inDomain DomainType,
+	outDomain DomainType,
+	periodStart time.Time,
+	periodEnd time.Time,
+	business *BusinessType,
+	docStatus *DocStatus,
 ) (*TransmissionNetworkMarketDocument, error) {
 	params := url.Values{}
 	params.Add(ParameterDocumentType, string(DocumentTypeInterconnectionNetworkExpansion))
@@ -399,7 +551,7 @@ func (c *EntsoeClient) GetExpansionAndDismantlingProjects(
 	if docStatus != nil {
 		params.Add(ParameterDocStatus, string(*docStatus))
 	}
-	return c.requestTransmissionNetworkMarketDocument(params)
+	return c.requestTransmissionNetworkMarketDocument(ctx, params)
 }
 
 // 4.2.2. Forecasted Capacity [11.1.A]
@@ -409,6 +561,18 @@ func (c *EntsoeClient) GetForecastedCapacity(
 	outDomain DomainType,
 	periodStart time.Time,
 	periodEnd time.Time,
+) (*PublicationMarketDocument, error) {
+	return c.GetForecastedCapacityCtx(context.Background(), contractMarketAgreement, inDomain, outDomain, periodStart, periodEnd)
+}
+
+// GetForecastedCapacityCtx is GetForecastedCapacity with a context.
+func (c *EntsoeClient) GetForecastedCapacityCtx(
+	ctx context.Context,
+	contractMarketAgreement ContractMarketAgreementType,
+	inDomain DomainType,
+	outDomain DomainType,
+	periodStart time.Time,
+	periodEnd time.Time,
 ) (*PublicationMarketDocument, error) {
 	params := url.Values{}
 	params.Add(ParameterDocumentType, string(DocumentTypeEstimatedNetTransferCapacity))
@@ -417,7 +581,7 @@ func (c *EntsoeClient) GetForecastedCapacity(
 	params.Add(ParameterOutDomain, string(outDomain))
 	params.Add(ParameterPeriodStart, periodStart.UTC().Format("200601021504"))
 	params.Add(ParameterPeriodEnd, periodEnd.UTC().Format("200601021504"))
-	return c.requestPublicationMarketDocument(params)
+	return c.requestPublicationMarketDocument(ctx, params)
 }
 
 // 4.2.3. Offered Capacity [11.1.A]
@@ -430,6 +594,21 @@ func (c *EntsoeClient) GetOfferedCapacity(
 	periodEnd time.Time,
 	auctionCategory *AuctionCategory,
 	classificationSequenceAttributeInstanceComponentPosition *int,
+) (*PublicationMarketDocument, error) {
+	return c.GetOfferedCapacityCtx(context.Background(), auctionType, contractMarketAgreement, inDomain, outDomain, periodStart, periodEnd, auctionCategory, classificationSequenceAttributeInstanceComponentPosition)
+}
+
+// GetOfferedCapacityCtx is GetOfferedCapacity with a context.
+func (c *EntsoeClient) GetOfferedCapacityCtx(
+	ctx context.Context,
+	auctionType AuctionType,
+	contractMarketAgreement ContractMarketAgreementType,
+	inDomain DomainType,
+	outDomain DomainType,
+	periodStart time.Time,
+	periodEnd time.Time,
+	auctionCategory *AuctionCategory,
+	classificationSequenceAttributeInstanceComponentPosition *int,
 ) (*PublicationMarketDocument, error) {
 	params := url.Values{}
 	params.Add(ParameterDocumentType, string(DocumentTypeAgreedCapacity))
@@ -445,7 +624,7 @@ func (c *EntsoeClient) GetOfferedCapacity(
 	if auctionCategory != nil {
 		params.Add(ParameterClassificationSequenceAttributeInstanceComponentPosition, strconv.Itoa(*classificationSequenceAttributeInstanceComponentPosition))
 	}
-	return c.requestPublicationMarketDocument(params)
+	return c.requestPublicationMarketDocument(ctx, params)
 }
 
 // 4.2.4. Flow-based Parameters [11.1.B]
@@ -454,6 +633,17 @@ func (c *EntsoeClient) GetFlowBasedParameters(
 	domain DomainType,
 	periodStart time.Time,
 	periodEnd time.Time,
+) (*CriticalNetworkElementMarketDocument, error) {
+	return c.GetFlowBasedParametersCtx(context.Background(), processType, domain, periodStart, periodEnd)
+}
+
+// GetFlowBasedParametersCtx is GetFlowBasedParameters with a context.
+func (c *EntsoeClient) GetFlowBasedParametersCtx(
+	ctx context.Context,
+	processType ProcessType,
+	domain DomainType,
+	periodStart time.Time,
+	periodEnd time.Time,
 ) (*CriticalNetworkElementMarketDocument, error) {
 	params := url.Values{}
 	params.Add(ParameterDocumentType, string(DocumentTypeFlowBasedAllocations))
@@ -462,7 +652,7 @@ func (c *EntsoeClient) GetFlowBasedParameters(
 	params.Add(ParameterOutDomain, string(domain))
 	params.Add(ParameterPeriodStart, periodStart.UTC().Format("200601021504"))
 	params.Add(ParameterPeriodEnd, periodEnd.UTC().Format("200601021504"))
-	return c.requestCriticalNetworkElementMarketDocument(params)
+	return c.requestCriticalNetworkElementMarketDocument(ctx, params)
 }
 
 // 4.2.5. Intraday Transfer Limits [11.3]
@@ -471,6 +661,17 @@ func (c *EntsoeClient) GetIntradayTransferLimits(
 	outDomain DomainType,
 	periodStart time.Time,
 	periodEnd time.Time,
+) (*PublicationMarketDocument, error) {
+	return c.GetIntradayTransferLimitsCtx(context.Background(), inDomain, outDomain, periodStart, periodEnd)
+}
+
+// GetIntradayTransferLimitsCtx is GetIntradayTransferLimits with a context.
+func (c *EntsoeClient) GetIntradayTransferLimitsCtx(
+	ctx context.Context,
+	inDomain DomainType,
+	outDomain DomainType,
+	periodStart time.Time,
+	periodEnd time.Time,
 ) (*PublicationMarketDocument, error) {
 	params := url.Values{}
 	params.Add(ParameterDocumentType, string(DocumentTypeDcLinkCapacity))
@@ -478,7 +679,7 @@ func (c *EntsoeClient) GetIntradayTransferLimits(
 	params.Add(ParameterOutDomain, string(outDomain))
 	params.Add(ParameterPeriodStart, periodStart.UTC().Format("200601021504"))
 	params.Add(ParameterPeriodEnd, periodEnd.UTC().Format("200601021504"))
-	return c.requestPublicationMarketDocument(params)
+	return c.requestPublicationMarketDocument(ctx, params)
 }
 
 // 4.2.6. Explicit Allocation Information (Capacity) [12.1.A]
@@ -492,6 +693,21 @@ func (c *EntsoeClient) GetExplicitAllocationInformation(
 	periodEnd time.Time,
 	auctionCategory *AuctionCategory,
 	classificationSequenceAttributeInstanceComponentPosition *int,
+) (*PublicationMarketDocument, error) {
+	return c.GetExplicitAllocationInformationCtx(context.Background(), businessType, contractMarketAgreementType, inDomain, outDomain, periodStart, periodEnd, auctionCategory, classificationSequenceAttributeInstanceComponentPosition)
+}
+
+// GetExplicitAllocationInformationCtx is GetExplicitAllocationInformation with a context.
+func (c *EntsoeClient) GetExplicitAllocationInformationCtx(
+	ctx context.Context,
+	businessType BusinessType,
+	contractMarketAgreementType ContractMarketAgreementType,
+	inDomain DomainType,
+	outDomain DomainType,
+	periodStart time.Time,
+	periodEnd time.Time,
+	auctionCategory *AuctionCategory,
+	classificationSequenceAttributeInstanceComponentPosition *int,
 ) (*PublicationMarketDocument, error) {
 	params := url.Values{}
 	params.Add(ParameterDocumentType, string(DocumentTypeAllocationResultDocument))
@@ -507,7 +723,7 @@ func (c *EntsoeClient) GetExplicitAllocationInformation(
 	if classificationSequenceAttributeInstanceComponentPosition != nil {
 		params.Add(ParameterClassificationSequenceAttributeInstanceComponentPosition, strconv.Itoa(*classificationSequenceAttributeInstanceComponentPosition))
 	}
-	return c.requestPublicationMarketDocument(params)
+	return c.requestPublicationMarketDocument(ctx, params)
 }
 
 // 4.2.8. Total Capacity Nominated [12.1.B]
@@ -517,6 +733,18 @@ func (c *EntsoeClient) GetTotalCapacityNominated(
 	outDomain DomainType,
 	periodStart time.Time,
 	periodEnd time.Time,
+) (*PublicationMarketDocument, error) {
+	return c.GetTotalCapacityNominatedCtx(context.Background(), businessType, inDomain, outDomain, periodStart, periodEnd)
+}
+
+// GetTotalCapacityNominatedCtx is GetTotalCapacityNominated with a context.
+func (c *EntsoeClient) GetTotalCapacityNominatedCtx(
+	ctx context.Context,
+	businessType BusinessType,
+	inDomain DomainType,
+	outDomain DomainType,
+	periodStart time.Time,
+	periodEnd time.Time,
 ) (*PublicationMarketDocument, error) {
 	params := url.Values{}
 	params.Add(ParameterDocumentType, string(DocumentTypeCapacityDocument))
@@ -525,7 +753,7 @@ func (c *EntsoeClient) GetTotalCapacityNominated(
 	params.Add(ParameterOutDomain, string(outDomain))
 	params.Add(ParameterPeriodStart, periodStart.UTC().Format("200601021504"))
 	params.Add(ParameterPeriodEnd, periodEnd.UTC().Format("200601021504"))
-	return c.requestPublicationMarketDocument(params)
+	return c.requestPublicationMarketDocument(ctx, params)
 }
 
 // 4.2.9. Total Capacity Already Allocated [12.1.C]
@@ -537,6 +765,20 @@ func (c *EntsoeClient) GetTotalCapacityAlreadyAllocated(
 	periodStart time.Time,
 	periodEnd time.Time,
 	auctionCategory *AuctionCategory,
+) (*PublicationMarketDocument, error) {
+	return c.GetTotalCapacityAlreadyAllocatedCtx(context.Background(), businessType, contractMarketAgreementType, inDomain, outDomain, periodStart, periodEnd, auctionCategory)
+}
+
+// GetTotalCapacityAlreadyAllocatedCtx is GetTotalCapacityAlreadyAllocated with a context.
+func (c *EntsoeClient) GetTotalCapacityAlreadyAllocatedCtx(
+	ctx context.Context,
+	businessType BusinessType,
+	contractMarketAgreementType ContractMarketAgreementType,
+	inDomain DomainType,
+	outDomain DomainType,
+	periodStart time.Time,
+	periodEnd time.Time,
+	auctionCategory *AuctionCategory,
 ) (*PublicationMarketDocument, error) {
 	params := url.Values{}
 	params.Add(ParameterDocumentType, string(DocumentTypeCapacityDocument))
@@ -548,7 +790,7 @@ func (c *EntsoeClient) GetTotalCapacityAlreadyAllocated(
 	if auctionCategory != nil {
 		params.Add(ParameterAuctionCategory, string(*auctionCategory))
 	}
-	return c.requestPublicationMarketDocument(params)
+	return c.requestPublicationMarketDocument(ctx, params)
 }
 
 // 4.2.10. Day Ahead Prices [12.1.D]
@@ -556,6 +798,16 @@ func (c *EntsoeClient) GetDayAheadPrices(
 	domain DomainType,
 	periodStart time.Time,
 	periodEnd time.Time,
+) (*PublicationMarketDocument, error) {
+	return c.GetDayAheadPricesCtx(context.Background(), domain, periodStart, periodEnd)
+}
+
+// GetDayAheadPricesCtx is GetDayAheadPrices with a context.
+func (c *EntsoeClient) GetDayAheadPricesCtx(
+	ctx context.Context,
+	domain DomainType,
+	periodStart time.Time,
+	periodEnd time.Time,
 ) (*PublicationMarketDocument, error) {
 	params := url.Values{}
 	params.Add(ParameterDocumentType, string(DocumentTypePriceDocument))
@@ -563,7 +815,7 @@ func (c *EntsoeClient) GetDayAheadPrices(
 	params.Add(ParameterOutDomain, string(domain))
 	params.Add(ParameterPeriodStart, periodStart.UTC().Format("200601021504"))
 	params.Add(ParameterPeriodEnd, periodEnd.UTC().Format("200601021504"))
-	return c.requestPublicationMarketDocument(params)
+	return c.requestPublicationMarketDocument(ctx, params)
 }
 
 // 4.2.11. Implicit Auction — Net Positions [12.1.E]
@@ -574,6 +826,18 @@ func (c *EntsoeClient) GetImplicitAuction(
 	domain DomainType,
 	periodStart time.Time,
 	periodEnd time.Time,
+) (*PublicationMarketDocument, error) {
+	return c.GetImplicitAuctionCtx(context.Background(), businessType, contractMarketAgreementType, domain, periodStart, periodEnd)
+}
+
+// GetImplicitAuctionCtx is GetImplicitAuction with a context.
+func (c *EntsoeClient) GetImplicitAuctionCtx(
+	ctx context.Context,
+	businessType BusinessType,
+	contractMarketAgreementType ContractMarketAgreementType,
+	domain DomainType,
+	periodStart time.Time,
+	periodEnd time.Time,
 ) (*PublicationMarketDocument, error) {
 	params := url.Values{}
 	params.Add(ParameterDocumentType, string(DocumentTypeAllocationResultDocument))
@@ -583,7 +847,7 @@ func (c *EntsoeClient) GetImplicitAuction(
 	params.Add(ParameterOutDomain, string(domain))
 	params.Add(ParameterPeriodStart, periodStart.UTC().Format("200601021504"))
 	params.Add(ParameterPeriodEnd, periodEnd.UTC().Format("200601021504"))
-	return c.requestPublicationMarketDocument(params)
+	return c.requestPublicationMarketDocument(ctx, params)
 }
 
 // 4.2.13. Total Commercial Schedules [12.1.F]
@@ -593,6 +857,18 @@ func (c *EntsoeClient) GetTotalCommercialSchedules(
 	periodStart time.Time,
 	periodEnd time.Time,
 	contractType *ContractMarketAgreementType,
+) (*PublicationMarketDocument, error) {
+	return c.GetTotalCommercialSchedulesCtx(context.Background(), inDomain, outDomain, periodStart, periodEnd, contractType)
+}
+
+// GetTotalCommercialSchedulesCtx is GetTotalCommercialSchedules with a context.
+func (c *EntsoeClient) GetTotalCommercialSchedulesCtx(
+	ctx context.Context,
+	inDomain DomainType,
+	outDomain DomainType,
+	periodStart time.Time,
+	periodEnd time.Time,
+	contractType *ContractMarketAgreementType,
 ) (*PublicationMarketDocument, error) {
 	params := url.Values{}
 	params.Add(ParameterDocumentType, string(DocumentTypeFinalisedSchedule))
@@ -603,7 +879,7 @@ func (c *EntsoeClient) GetTotalCommercialSchedules(
 	if contractType != nil {
 		params.Add(ParameterContractMarketAgreementType, string(*contractType))
 	}
-	return c.requestPublicationMarketDocument(params)
+	return c.requestPublicationMarketDocument(ctx, params)
 }
 
 // 4.2.14. Day-ahead Commercial Schedules [12.1.F]
@@ -613,6 +889,18 @@ func (c *EntsoeClient) GetDayAheadCommercialSchedules(
 	periodStart time.Time,
 	periodEnd time.Time,
 	contractType *ContractMarketAgreementType,
+) (*PublicationMarketDocument, error) {
+	return c.GetDayAheadCommercialSchedulesCtx(context.Background(), inDomain, outDomain, periodStart, periodEnd, contractType)
+}
+
+// GetDayAheadCommercialSchedulesCtx is GetDayAheadCommercialSchedules with a context.
+func (c *EntsoeClient) GetDayAheadCommercialSchedulesCtx(
+	ctx context.Context,
+	inDomain DomainType,
+	outDomain DomainType,
+	periodStart time.Time,
+	periodEnd time.Time,
+	contractType *ContractMarketAgreementType,
 ) (*PublicationMarketDocument, error) {
 	params := url.Values{}
 	params.Add(ParameterDocumentType, string(DocumentTypeFinalisedSchedule))
@@ -623,7 +911,7 @@ func (c *EntsoeClient) GetDayAheadCommercialSchedules(
 	if contractType != nil {
 		params.Add(ParameterContractMarketAgreementType, string(*contractType))
 	}
-	return c.requestPublicationMarketDocument(params)
+	return c.requestPublicationMarketDocument(ctx, params)
 }
 
 // 4.2.15. Physical Flows [12.1.G]
@@ -632,6 +920,17 @@ func (c *EntsoeClient) GetPhysicalFlows(
 	outDomain DomainType,
 	periodStart time.Time,
 	periodEnd time.Time,
+) (*PublicationMarketDocument, error) {
+	return c.GetPhysicalFlowsCtx(context.Background(), inDomain, outDomain, periodStart, periodEnd)
+}
+
+// GetPhysicalFlowsCtx is GetPhysicalFlows with a context.
+func (c *EntsoeClient) GetPhysicalFlowsCtx(
+	ctx context.Context,
+	inDomain DomainType,
+	outDomain DomainType,
+	periodStart time.Time,
+	periodEnd time.Time,
 ) (*PublicationMarketDocument, error) {
 	params := url.Values{}
 	params.Add(ParameterDocumentType, string(DocumentTypeAggregatedEnergyDataReport))
@@ -639,7 +938,7 @@ func (c *EntsoeClient) GetPhysicalFlows(
 	params.Add(ParameterOutDomain, string(outDomain))
 	params.Add(ParameterPeriodStart, periodStart.UTC().Format("200601021504"))
 	params.Add(ParameterPeriodEnd, periodEnd.UTC().Format("200601021504"))
-	return c.requestPublicationMarketDocument(params)
+	return c.requestPublicationMarketDocument(ctx, params)
 }
 
 // 4.2.16. Capacity Allocated Outside EU [12.1.H]
@@ -652,6 +951,21 @@ func (c *EntsoeClient) GetCapacityAllocatedOutsideEu(
 	periodEnd time.Time,
 	auctionCategory *AuctionCategory,
 	classificationSequenceAttributeInstanceComponentPosition *int,
+) (*PublicationMarketDocument, error) {
+	return c.GetCapacityAllocatedOutsideEuCtx(context.Background(), auctionType, contractMarketAgreementType, inDomain, outDomain, periodStart, periodEnd, auctionCategory, classificationSequenceAttributeInstanceComponentPosition)
+}
+
+// GetCapacityAllocatedOutsideEuCtx is GetCapacityAllocatedOutsideEu with a context.
+func (c *EntsoeClient) GetCapacityAllocatedOutsideEuCtx(
+	ctx context.Context,
+	auctionType AuctionType,
+	contractMarketAgreementType ContractMarketAgreementType,
+	inDomain DomainType,
+	outDomain DomainType,
+	periodStart time.Time,
+	periodEnd time.Time,
+	auctionCategory *AuctionCategory,
+	classificationSequenceAttributeInstanceComponentPosition *int,
 ) (*PublicationMarketDocument, error) {
 	params := url.Values{}
 	params.Add(ParameterDocumentType, string(DocumentTypeNonEuAllocations))
@@ -667,7 +981,7 @@ func (c *EntsoeClient) GetCapacityAllocatedOutsideEu(
 	if classificationSequenceAttributeInstanceComponentPosition != nil {
 		params.Add(ParameterClassificationSequenceAttributeInstanceComponentPosition, strconv.Itoa(*classificationSequenceAttributeInstanceComponentPosition))
 	}
-	return c.requestPublicationMarketDocument(params)
+	return c.requestPublicationMarketDocument(ctx, params)
 }
 
 // 4.3. Congestion domain
@@ -679,6 +993,18 @@ func (c *EntsoeClient) GetRedispatching(
 	periodStart time.Time,
 	periodEnd time.Time,
 	business *BusinessType,
+) (*TransmissionNetworkMarketDocument, error) {
+	return c.GetRedispatchingCtx(context.Background(), inDomain, outDomain, periodStart, periodEnd, business)
+}
+
+// GetRedispatchingCtx is GetRedispatching with a context.
+func (c *EntsoeClient) GetRedispatchingCtx(
+	ctx context.Context,
+	inDomain DomainType,
+	outDomain DomainType,
+	periodStart time.Time,
+	periodEnd time.Time,
+	business *BusinessType,
 ) (*TransmissionNetworkMarketDocument, error) {
 	params := url.Values{}
 	params.Add(ParameterDocumentType, string(DocumentTypeRedispatchNotice))
@@ -689,7 +1015,7 @@ func (c *EntsoeClient) GetRedispatching(
 	if business != nil {
 		params.Add(ParameterBusinessType, string(*business))
 	}
-	return c.requestTransmissionNetworkMarketDocument(params)
+	return c.requestTransmissionNetworkMarketDocument(ctx, params)
 }
 
 // 4.3.2. Countertrading [13.1.B]
@@ -698,6 +1024,17 @@ func (c *EntsoeClient) GetCountertrading(
 	outDomain DomainType,
 	periodStart time.Time,
 	periodEnd time.Time,
+) (*TransmissionNetworkMarketDocument, error) {
+	return c.GetCountertradingCtx(context.Background(), inDomain, outDomain, periodStart, periodEnd)
+}
+
+// GetCountertradingCtx is GetCountertrading with a context.
+func (c *EntsoeClient) GetCountertradingCtx(
+	ctx context.Context,
+	inDomain DomainType,
+	outDomain DomainType,
+	periodStart time.Time,
+	periodEnd time.Time,
 ) (*TransmissionNetworkMarketDocument, error) {
 	params := url.Values{}
 	params.Add(ParameterDocumentType, string(DocumentTypeCounterTradeNotice))
@@ -705,7 +1042,7 @@ func (c *EntsoeClient) GetCountertrading(
 	params.Add(ParameterOutDomain, string(outDomain))
 	params.Add(ParameterPeriodStart, periodStart.UTC().Format("200601021504"))
 	params.Add(ParameterPeriodEnd, periodEnd.UTC().Format("200601021504"))
-	return c.requestTransmissionNetworkMarketDocument(params)
+	return c.requestTransmissionNetworkMarketDocument(ctx, params)
 }
 
 // 4.3.3. Costs of Congestion Management [13.1.C]
@@ -714,6 +1051,17 @@ func (c *EntsoeClient) GetCostsOfCongestionManagement(
 	periodStart time.Time,
 	periodEnd time.Time,
 	business *BusinessType,
+) (*TransmissionNetworkMarketDocument, error) {
+	return c.GetCostsOfCongestionManagementCtx(context.Background(), domain, periodStart, periodEnd, business)
+}
+
+// GetCostsOfCongestionManagementCtx is GetCostsOfCongestionManagement with a context.
+func (c *EntsoeClient) GetCostsOfCongestionManagementCtx(
+	ctx context.Context,
+	domain DomainType,
+	periodStart time.Time,
+	periodEnd time.Time,
+	business *BusinessType,
 ) (*TransmissionNetworkMarketDocument, error) {
 	params := url.Values{}
 	params.Add(ParameterDocumentType, string(DocumentTypeCongestionCosts))
@@ -724,7 +1072,7 @@ func (c *EntsoeClient) GetCostsOfCongestionManagement(
 	if business != nil {
 		params.Add(ParameterBusinessType, string(*business))
 	}
-	return c.requestTransmissionNetworkMarketDocument(params)
+	return c.requestTransmissionNetworkMarketDocument(ctx, params)
 }
 
 // 4.4. Generation domain
@@ -736,6 +1084,18 @@ func (c *EntsoeClient) GetInstalledGenerationCapacityAggregated(
 	periodStart time.Time,
 	periodEnd time.Time,
 	psrType *PsrType,
+) (*GLMarketDocument, error) {
+	return c.GetInstalledGenerationCapacityAggregatedCtx(context.Background(), processType, inDomain, periodStart, periodEnd, psrType)
+}
+
+// GetInstalledGenerationCapacityAggregatedCtx is GetInstalledGenerationCapacityAggregated with a context.
+func (c *EntsoeClient) GetInstalledGenerationCapacityAggregatedCtx(
+	ctx context.Context,
+	processType ProcessType,
+	inDomain DomainType,
+	periodStart time.Time,
+	periodEnd time.Time,
+	psrType *PsrType,
 ) (*GLMarketDocument, error) {
 	params := url.Values{}
 	params.Add(ParameterDocumentType, string(DocumentTypeInstalledGenerationPerType))
@@ -746,7 +1106,7 @@ func (c *EntsoeClient) GetInstalledGenerationCapacityAggregated(
 	if psrType != nil {
 		params.Add(ParameterPsrType, string(*psrType))
 	}
-	return c.requestGLMarketDocument(params)
+	return c.requestGLMarketDocument(ctx, params)
 }
 
 // 4.4.2. Installed Generation Capacity per Unit [14.1.B]
@@ -757,6 +1117,18 @@ func (c *EntsoeClient) GetInstalledGenerationCapacityPerUnit(
 	periodStart time.Time,
 	periodEnd time.Time,
 	psrType *PsrType,
+) (*GLMarketDocument, error) {
+	return c.GetInstalledGenerationCapacityPerUnitCtx(context.Background(), processType, inDomain, periodStart, periodEnd, psrType)
+}
+
+// GetInstalledGenerationCapacityPerUnitCtx is GetInstalledGenerationCapacityPerUnit with a context.
+func (c *EntsoeClient) GetInstalledGenerationCapacityPerUnitCtx(
+	ctx context.Context,
+	processType ProcessType,
+	inDomain DomainType,
+	periodStart time.Time,
+	periodEnd time.Time,
+	psrType *PsrType,
 ) (*GLMarketDocument, error) {
 	params := url.Values{}
 	params.Add(ParameterDocumentType, string(DocumentTypeGenerationForecast))
@@ -767,7 +1139,7 @@ func (c *EntsoeClient) GetInstalledGenerationCapacityPerUnit(
 	if psrType != nil {
 		params.Add(ParameterPsrType, string(*psrType))
 	}
-	return c.requestGLMarketDocument(params)
+	return c.requestGLMarketDocument(ctx, params)
 }
 
 // 4.4.3. Day-ahead Aggregated Generation [14.1.C]
@@ -776,6 +1148,17 @@ func (c *EntsoeClient) GetDayAheadAggregatedGeneration(
 	inDomain DomainType,
 	periodStart time.Time,
 	periodEnd time.Time,
+) (*GLMarketDocument, error) {
+	return c.GetDayAheadAggregatedGenerationCtx(context.Background(), processType, inDomain, periodStart, periodEnd)
+}
+
+// GetDayAheadAggregatedGenerationCtx is GetDayAheadAggregatedGeneration with a context.
+func (c *EntsoeClient) GetDayAheadAggregatedGenerationCtx(
+	ctx context.Context,
+	processType ProcessType,
+	inDomain DomainType,
+	periodStart time.Time,
+	periodEnd time.Time,
 ) (*GLMarketDocument, error) {
 	params := url.Values{}
 	params.Add(ParameterDocumentType, string(DocumentTypeGenerationForecast))
@@ -783,7 +1166,7 @@ func (c *EntsoeClient) GetDayAheadAggregatedGeneration(
 	params.Add(ParameterInDomain, string(inDomain))
 	params.Add(ParameterPeriodStart, periodStart.UTC().Format("200601021504"))
 	params.Add(ParameterPeriodEnd, periodEnd.UTC().Format("200601021504"))
-	return c.requestGLMarketDocument(params)
+	return c.requestGLMarketDocument(ctx, params)
 }
 
 // 4.4.4. Day-ahead Generation Forecasts for Wind and Solar [14.1.D]
@@ -795,6 +1178,18 @@ func (c *EntsoeClient) GetGenerationForecastsForWindAndSolar(
 	periodStart time.Time,
 	periodEnd time.Time,
 	psrType *PsrType,
+) (*GLMarketDocument, error) {
+	return c.GetGenerationForecastsForWindAndSolarCtx(context.Background(), processType, inDomain, periodStart, periodEnd, psrType)
+}
+
+// GetGenerationForecastsForWindAndSolarCtx is GetGenerationForecastsForWindAndSolar with a context.
+func (c *EntsoeClient) GetGenerationForecastsForWindAndSolarCtx(
+	ctx context.Context,
+	processType ProcessType,
+	inDomain DomainType,
+	periodStart time.Time,
+	periodEnd time.Time,
+	psrType *PsrType,
 ) (*GLMarketDocument, error) {
 	params := url.Values{}
 	params.Add(ParameterDocumentType, string(DocumentTypeWindAndSolarForecast))
@@ -805,7 +1200,7 @@ func (c *EntsoeClient) GetGenerationForecastsForWindAndSolar(
 	if psrType != nil {
 		params.Add(ParameterPsrType, string(*psrType))
 	}
-	return c.requestGLMarketDocument(params)
+	return c.requestGLMarketDocument(ctx, params)
 }
 
 // 4.4.7. Actual Generation Output per Generation Unit [16.1.A]
@@ -816,6 +1211,18 @@ func (c *EntsoeClient) GetActualGenerationOutputPerGenerationUnit(
 	periodStart time.Time,
 	periodEnd time.Time,
 	psrType *PsrType,
+) (*GLMarketDocument, error) {
+	return c.GetActualGenerationOutputPerGenerationUnitCtx(context.Background(), processType, inDomain, periodStart, periodEnd, psrType)
+}
+
+// GetActualGenerationOutputPerGenerationUnitCtx is GetActualGenerationOutputPerGenerationUnit with a context.
+func (c *EntsoeClient) GetActualGenerationOutputPerGenerationUnitCtx(
+	ctx context.Context,
+	processType ProcessType,
+	inDomain DomainType,
+	periodStart time.Time,
+	periodEnd time.Time,
+	psrType *PsrType,
 ) (*GLMarketDocument, error) {
 	params := url.Values{}
 	params.Add(ParameterDocumentType, string(DocumentTypeActualGeneration))
@@ -826,7 +1233,7 @@ func (c *EntsoeClient) GetActualGenerationOutputPerGenerationUnit(
 	if psrType != nil {
 		params.Add(ParameterPsrType, string(*psrType))
 	}
-	return c.requestGLMarketDocument(params)
+	return c.requestGLMarketDocument(ctx, params)
 }
 
 // 4.4.8. Aggregated Generation per Type [16.1.B&C]
@@ -836,6 +1243,18 @@ func (c *EntsoeClient) GetAggregatedGenerationPerType(
 	inDomain DomainType,
 	periodStart time.Time,
 	periodEnd time.Time,
+) (*GLMarketDocument, error) {
+	return c.GetAggregatedGenerationPerTypeCtx(context.Background(), processType, psrType, inDomain, periodStart, periodEnd)
+}
+
+// GetAggregatedGenerationPerTypeCtx is GetAggregatedGenerationPerType with a context.
+func (c *EntsoeClient) GetAggregatedGenerationPerTypeCtx(
+	ctx context.Context,
+	processType ProcessType,
+	psrType PsrType,
+	inDomain DomainType,
+	periodStart time.Time,
+	periodEnd time.Time,
 ) (*GLMarketDocument, error) {
 	params := url.Values{}
 	params.Add(ParameterDocumentType, string(DocumentTypeActualGenerationPerType))
@@ -844,7 +1263,7 @@ func (c *EntsoeClient) GetAggregatedGenerationPerType(
 	params.Add(ParameterInDomain, string(inDomain))
 	params.Add(ParameterPeriodStart, periodStart.UTC().Format("200601021504"))
 	params.Add(ParameterPeriodEnd, periodEnd.UTC().Format("200601021504"))
-	return c.requestGLMarketDocument(params)
+	return c.requestGLMarketDocument(ctx, params)
 }
 
 // 4.4.9. Aggregated Filling Rate of Water Reservoirs and Hydro Storage Plants [16.1.D]
@@ -853,6 +1272,17 @@ func (c *EntsoeClient) GetAggregatedFillingRateOfWaterReservoirsAndHydroStorageP
 	inDomain DomainType,
 	periodStart time.Time,
 	periodEnd time.Time,
+) (*GLMarketDocument, error) {
+	return c.GetAggregatedFillingRateOfWaterReservoirsAndHydroStoragePlantsCtx(context.Background(), processType, inDomain, periodStart, periodEnd)
+}
+
+// GetAggregatedFillingRateOfWaterReservoirsAndHydroStoragePlantsCtx is GetAggregatedFillingRateOfWaterReservoirsAndHydroStoragePlants with a context.
+func (c *EntsoeClient) GetAggregatedFillingRateOfWaterReservoirsAndHydroStoragePlantsCtx(
+	ctx context.Context,
+	processType ProcessType,
+	inDomain DomainType,
+	periodStart time.Time,
+	periodEnd time.Time,
 ) (*GLMarketDocument, error) {
 	params := url.Values{}
 	params.Add(ParameterDocumentType, string(DocumentTypeReservoirFillingInformation))
@@ -860,161 +1290,1175 @@ func (c *EntsoeClient) GetAggregatedFillingRateOfWaterReservoirsAndHydroStorageP
 	params.Add(ParameterInDomain, string(inDomain))
 	params.Add(ParameterPeriodStart, periodStart.UTC().Format("200601021504"))
 	params.Add(ParameterPeriodEnd, periodEnd.UTC().Format("200601021504"))
-	return c.requestGLMarketDocument(params)
+	return c.requestGLMarketDocument(ctx, params)
 }
 
-func (c *EntsoeClient) requestGLMarketDocument(params url.Values) (*GLMarketDocument, error) {
-	paramStr := params.Encode()
-	data, err := c.sendRequest(paramStr)
-	if err != nil {
-		return nil, err
-	}
-	var doc GLMarketDocument
-	err = xml.Unmarshal(data, &doc)
-	if err != nil {
-		fmt.Println(string(data))
-		return nil, err
-	}
-	return &doc, nil
+// 4.5. Master Data
+
+// 4.5.1. Production and Generation Units
+func (c *EntsoeClient) GetProductionAndGenerationUnits(
+	biddingZoneDomain DomainType,
+	implementationDate time.Time,
+	psrType *PsrType,
+) (*ConfigurationMarketDocument, error) {
+	return c.GetProductionAndGenerationUnitsCtx(context.Background(), biddingZoneDomain, implementationDate, psrType)
 }
 
-func (c *EntsoeClient) requestTransmissionNetworkMarketDocument(params url.Values) (*TransmissionNetworkMarketDocument, error) {
-	paramStr := params.Encode()
-	data, err := c.sendRequest(paramStr)
-	if err != nil {
-		return nil, err
+// GetProductionAndGenerationUnitsCtx is GetProductionAndGenerationUnits with a context.
+func (c *EntsoeClient) GetProductionAndGenerationUnitsCtx(
+	ctx context.Context,
+	biddingZoneDomain DomainType,
+	implementationDate time.Time,
+	psrType *PsrType,
+) (*ConfigurationMarketDocument, error) {
+	params := url.Values{}
+	params.Add(ParameterDocumentType, string(DocumentTypeConfigurationDocument))
+	params.Add(ParameterBusinessType, string(BusinessTypeProductionUnit))
+	params.Add(ParameterBiddingZoneDomain, string(biddingZoneDomain))
+	params.Add(ParameterImplementationDateAndOrTime, implementationDate.Format("2006-01-02"))
+	if psrType != nil {
+		params.Add(ParameterPsrType, string(*psrType))
 	}
+	return c.requestConfigurationMarketDocument(ctx, params)
+}
 
-	var doc TransmissionNetworkMarketDocument
-	err = xml.Unmarshal(data, &doc)
+// 4.6. Balancing domain
+
+// 4.6.1. Current Balancing State [GL EB 12.3.A]
+func (c *EntsoeClient) GetCurrentBalancingState(
+	areaDomain DomainType,
+	periodStart time.Time,
+	periodEnd time.Time,
+) (*BalancingMarketDocument, error) {
+	return c.GetCurrentBalancingStateCtx(context.Background(), areaDomain, periodStart, periodEnd)
+}
+
+// GetCurrentBalancingStateCtx is GetCurrentBalancingState with a context.
+func (c *EntsoeClient) GetCurrentBalancingStateCtx(
+	ctx context.Context,
+	areaDomain DomainType,
+	periodStart time.Time,
+	periodEnd time.Time,
+) (*BalancingMarketDocument, error) {
+	params := url.Values{}
+	params.Add(ParameterDocumentType, string(DocumentTypeImbalanceVolume))
+	params.Add(ParameterBusinessType, string(BusinessTypeAreaControlError))
+	params.Add(ParameterAreaDomain, string(areaDomain))
+	params.Add(ParameterPeriodStart, periodStart.UTC().Format("200601021504"))
+	params.Add(ParameterPeriodEnd, periodEnd.UTC().Format("200601021504"))
+	return c.requestBalancingMarketDocument(ctx, params)
+}
+
+// 4.6.2. Aggregated Balancing Energy Bids [GL EB 12.3.E]
+func (c *EntsoeClient) GetAggregatedBalancingEnergyBids(
+	processType ProcessType,
+	areaDomain DomainType,
+	periodStart time.Time,
+	periodEnd time.Time,
+) (*BalancingMarketDocument, error) {
+	return c.GetAggregatedBalancingEnergyBidsCtx(context.Background(), processType, areaDomain, periodStart, periodEnd)
+}
+
+// GetAggregatedBalancingEnergyBidsCtx is GetAggregatedBalancingEnergyBids with a context.
+func (c *EntsoeClient) GetAggregatedBalancingEnergyBidsCtx(
+	ctx context.Context,
+	processType ProcessType,
+	areaDomain DomainType,
+	periodStart time.Time,
+	periodEnd time.Time,
+) (*BalancingMarketDocument, error) {
+	params := url.Values{}
+	params.Add(ParameterDocumentType, string(DocumentTypeBidDocument))
+	params.Add(ParameterProcessType, string(processType))
+	params.Add(ParameterAreaDomain, string(areaDomain))
+	params.Add(ParameterPeriodStart, periodStart.UTC().Format("200601021504"))
+	params.Add(ParameterPeriodEnd, periodEnd.UTC().Format("200601021504"))
+	return c.requestBalancingMarketDocument(ctx, params)
+}
+
+// 4.6.3. Prices of Activated Balancing Energy [GL EB 12.3.F]
+func (c *EntsoeClient) GetBalancingEnergyPrices(
+	processType ProcessType,
+	areaDomain DomainType,
+	periodStart time.Time,
+	periodEnd time.Time,
+) (*BalancingMarketDocument, error) {
+	return c.GetBalancingEnergyPricesCtx(context.Background(), processType, areaDomain, periodStart, periodEnd)
+}
+
+// GetBalancingEnergyPricesCtx is GetBalancingEnergyPrices with a context.
+func (c *EntsoeClient) GetBalancingEnergyPricesCtx(
+	ctx context.Context,
+	processType ProcessType,
+	areaDomain DomainType,
+	periodStart time.Time,
+	periodEnd time.Time,
+) (*BalancingMarketDocument, error) {
+	params := url.Values{}
+	params.Add(ParameterDocumentType, string(DocumentTypeAcquiringSystemOperatorReserveSchedule))
+	params.Add(ParameterProcessType, string(processType))
+	params.Add(ParameterAreaDomain, string(areaDomain))
+	params.Add(ParameterPeriodStart, periodStart.UTC().Format("200601021504"))
+	params.Add(ParameterPeriodEnd, periodEnd.UTC().Format("200601021504"))
+	return c.requestBalancingMarketDocument(ctx, params)
+}
+
+// 4.6.4. Use of Allocated Cross-Zonal Balancing Capacity [GL EB 12.3.H&I]
+func (c *EntsoeClient) GetUseOfAllocatedCrossZonalBalancingCapacity(
+	processType ProcessType,
+	acquiringDomain DomainType,
+	connectingDomain DomainType,
+	periodStart time.Time,
+	periodEnd time.Time,
+) (*BalancingMarketDocument, error) {
+	return c.GetUseOfAllocatedCrossZonalBalancingCapacityCtx(context.Background(), processType, acquiringDomain, connectingDomain, periodStart, periodEnd)
+}
+
+// GetUseOfAllocatedCrossZonalBalancingCapacityCtx is GetUseOfAllocatedCrossZonalBalancingCapacity with a context.
+func (c *EntsoeClient) GetUseOfAllocatedCrossZonalBalancingCapacityCtx(
+	ctx context.Context,
+	processType ProcessType,
+	acquiringDomain DomainType,
+	connectingDomain DomainType,
+	periodStart time.Time,
+	periodEnd time.Time,
+) (*BalancingMarketDocument, error) {
+	params := url.Values{}
+	params.Add(ParameterDocumentType, string(DocumentTypeReserveAllocationResultDocument))
+	params.Add(ParameterProcessType, string(processType))
+	params.Add(ParameterAcquiringDomain, string(acquiringDomain))
+	params.Add(ParameterConnectingDomain, string(connectingDomain))
+	params.Add(ParameterPeriodStart, periodStart.UTC().Format("200601021504"))
+	params.Add(ParameterPeriodEnd, periodEnd.UTC().Format("200601021504"))
+	return c.requestBalancingMarketDocument(ctx, params)
+}
+
+// 4.6.5. Amount of Balancing Reserves Under Contract [17.1.B]
+func (c *EntsoeClient) GetAmountOfBalancingReservesUnderContract(
+	typeMarketAgreementType ContractMarketAgreementType,
+	controlAreaDomain DomainType,
+	periodStart time.Time,
+	periodEnd time.Time,
+	businessType *BusinessType,
+	psrType *PsrType,
+) (*BalancingMarketDocument, error) {
+	return c.GetAmountOfBalancingReservesUnderContractCtx(context.Background(), typeMarketAgreementType, controlAreaDomain, periodStart, periodEnd, businessType, psrType)
+}
+
+// GetAmountOfBalancingReservesUnderContractCtx is GetAmountOfBalancingReservesUnderContract with a context.
+func (c *EntsoeClient) GetAmountOfBalancingReservesUnderContractCtx(
+	ctx context.Context,
+	typeMarketAgreementType ContractMarketAgreementType,
+	controlAreaDomain DomainType,
+	periodStart time.Time,
+	periodEnd time.Time,
+	businessType *BusinessType,
+	psrType *PsrType,
+) (*BalancingMarketDocument, error) {
+	params := url.Values{}
+	params.Add(ParameterDocumentType, string(DocumentTypeContractedReserves))
+	params.Add(ParameterTypeMarketAgreementType, string(typeMarketAgreementType))
+	params.Add(ParameterControlAreaDomain, string(controlAreaDomain))
+	params.Add(ParameterPeriodStart, periodStart.UTC().Format("200601021504"))
+	params.Add(ParameterPeriodEnd, periodEnd.UTC().Format("200601021504"))
+	if businessType != nil {
+		params.Add(ParameterBusinessType, string(*businessType))
+	}
+	if psrType != nil {
+		params.Add(ParameterPsrType, string(*psrType))
+	}
+	return c.requestBalancingMarketDocument(ctx, params)
+}
+
+// 4.6.6. Prices of Procured Balancing Reserves [17.1.C]
+func (c *EntsoeClient) GetPricesOfProcuredBalancingReserves(
+	typeMarketAgreementType ContractMarketAgreementType,
+	controlAreaDomain DomainType,
+	periodStart time.Time,
+	periodEnd time.Time,
+	businessType *BusinessType,
+	psrType *PsrType,
+) (*BalancingMarketDocument, error) {
+	return c.GetPricesOfProcuredBalancingReservesCtx(context.Background(), typeMarketAgreementType, controlAreaDomain, periodStart, periodEnd, businessType, psrType)
+}
+
+// GetPricesOfProcuredBalancingReservesCtx is GetPricesOfProcuredBalancingReserves with a context.
+func (c *EntsoeClient) GetPricesOfProcuredBalancingReservesCtx(
+	ctx context.Context,
+	typeMarketAgreementType ContractMarketAgreementType,
+	controlAreaDomain DomainType,
+	periodStart time.Time,
+	periodEnd time.Time,
+	businessType *BusinessType,
+	psrType *PsrType,
+) (*BalancingMarketDocument, error) {
+	params := url.Values{}
+	params.Add(ParameterDocumentType, string(DocumentTypeContractedReservePrices))
+	params.Add(ParameterTypeMarketAgreementType, string(typeMarketAgreementType))
+	params.Add(ParameterControlAreaDomain, string(controlAreaDomain))
+	params.Add(ParameterPeriodStart, periodStart.UTC().Format("200601021504"))
+	params.Add(ParameterPeriodEnd, periodEnd.UTC().Format("200601021504"))
+	if businessType != nil {
+		params.Add(ParameterBusinessType, string(*businessType))
+	}
+	if psrType != nil {
+		params.Add(ParameterPsrType, string(*psrType))
+	}
+	return c.requestBalancingMarketDocument(ctx, params)
+}
+
+// 4.6.7. Accepted Aggregated Offers [17.1.D]
+func (c *EntsoeClient) GetAcceptedAggregatedOffers(
+	controlAreaDomain DomainType,
+	periodStart time.Time,
+	periodEnd time.Time,
+	businessType *BusinessType,
+	psrType *PsrType,
+) (*BalancingMarketDocument, error) {
+	return c.GetAcceptedAggregatedOffersCtx(context.Background(), controlAreaDomain, periodStart, periodEnd, businessType, psrType)
+}
+
+// GetAcceptedAggregatedOffersCtx is GetAcceptedAggregatedOffers with a context.
+func (c *EntsoeClient) GetAcceptedAggregatedOffersCtx(
+	ctx context.Context,
+	controlAreaDomain DomainType,
+	periodStart time.Time,
+	periodEnd time.Time,
+	businessType *BusinessType,
+	psrType *PsrType,
+) (*BalancingMarketDocument, error) {
+	params := url.Values{}
+	params.Add(ParameterDocumentType, string(DocumentTypeAcceptedOffers))
+	params.Add(ParameterControlAreaDomain, string(controlAreaDomain))
+	params.Add(ParameterPeriodStart, periodStart.UTC().Format("200601021504"))
+	params.Add(ParameterPeriodEnd, periodEnd.UTC().Format("200601021504"))
+	if businessType != nil {
+		params.Add(ParameterBusinessType, string(*businessType))
+	}
+	if psrType != nil {
+		params.Add(ParameterPsrType, string(*psrType))
+	}
+	return c.requestBalancingMarketDocument(ctx, params)
+}
+
+// 4.6.8. Activated Balancing Energy [17.1.E]
+func (c *EntsoeClient) GetActivatedBalancingEnergy(
+	controlAreaDomain DomainType,
+	periodStart time.Time,
+	periodEnd time.Time,
+	businessType *BusinessType,
+	psrType *PsrType,
+) (*BalancingMarketDocument, error) {
+	return c.GetActivatedBalancingEnergyCtx(context.Background(), controlAreaDomain, periodStart, periodEnd, businessType, psrType)
+}
+
+// GetActivatedBalancingEnergyCtx is GetActivatedBalancingEnergy with a context.
+func (c *EntsoeClient) GetActivatedBalancingEnergyCtx(
+	ctx context.Context,
+	controlAreaDomain DomainType,
+	periodStart time.Time,
+	periodEnd time.Time,
+	businessType *BusinessType,
+	psrType *PsrType,
+) (*BalancingMarketDocument, error) {
+	params := url.Values{}
+	params.Add(ParameterDocumentType, string(DocumentTypeActivatedBalancingQuantities))
+	params.Add(ParameterControlAreaDomain, string(controlAreaDomain))
+	params.Add(ParameterPeriodStart, periodStart.UTC().Format("200601021504"))
+	params.Add(ParameterPeriodEnd, periodEnd.UTC().Format("200601021504"))
+	if businessType != nil {
+		params.Add(ParameterBusinessType, string(*businessType))
+	}
+	if psrType != nil {
+		params.Add(ParameterPsrType, string(*psrType))
+	}
+	return c.requestBalancingMarketDocument(ctx, params)
+}
+
+// 4.6.9. Prices of Activated Balancing Energy [17.1.F]
+func (c *EntsoeClient) GetPricesOfActivatedBalancingEnergy(
+	controlAreaDomain DomainType,
+	periodStart time.Time,
+	periodEnd time.Time,
+	businessType *BusinessType,
+	psrType *PsrType,
+) (*BalancingMarketDocument, error) {
+	return c.GetPricesOfActivatedBalancingEnergyCtx(context.Background(), controlAreaDomain, periodStart, periodEnd, businessType, psrType)
+}
+
+// GetPricesOfActivatedBalancingEnergyCtx is GetPricesOfActivatedBalancingEnergy with a context.
+func (c *EntsoeClient) GetPricesOfActivatedBalancingEnergyCtx(
+	ctx context.Context,
+	controlAreaDomain DomainType,
+	periodStart time.Time,
+	periodEnd time.Time,
+	businessType *BusinessType,
+	psrType *PsrType,
+) (*BalancingMarketDocument, error) {
+	params := url.Values{}
+	params.Add(ParameterDocumentType, string(DocumentTypeActivatedBalancingPrices))
+	params.Add(ParameterControlAreaDomain, string(controlAreaDomain))
+	params.Add(ParameterPeriodStart, periodStart.UTC().Format("200601021504"))
+	params.Add(ParameterPeriodEnd, periodEnd.UTC().Format("200601021504"))
+	if businessType != nil {
+		params.Add(ParameterBusinessType, string(*businessType))
+	}
+	if psrType != nil {
+		params.Add(ParameterPsrType, string(*psrType))
+	}
+	return c.requestBalancingMarketDocument(ctx, params)
+}
+
+// 4.6.10. Imbalance Prices [17.1.G]
+func (c *EntsoeClient) GetImbalancePrices(
+	controlAreaDomain DomainType,
+	periodStart time.Time,
+	periodEnd time.Time,
+) (*BalancingMarketDocument, error) {
+	return c.GetImbalancePricesCtx(context.Background(), controlAreaDomain, periodStart, periodEnd)
+}
+
+// GetImbalancePricesCtx is GetImbalancePrices with a context.
+func (c *EntsoeClient) GetImbalancePricesCtx(
+	ctx context.Context,
+	controlAreaDomain DomainType,
+	periodStart time.Time,
+	periodEnd time.Time,
+) (*BalancingMarketDocument, error) {
+	params := url.Values{}
+	params.Add(ParameterDocumentType, string(DocumentTypeImbalancePrices))
+	params.Add(ParameterControlAreaDomain, string(controlAreaDomain))
+	params.Add(ParameterPeriodStart, periodStart.UTC().Format("200601021504"))
+	params.Add(ParameterPeriodEnd, periodEnd.UTC().Format("200601021504"))
+	return c.requestBalancingMarketDocument(ctx, params)
+}
+
+// 4.6.11. Total Imbalance Volumes [17.1.H]
+func (c *EntsoeClient) GetTotalImbalanceVolumes(
+	controlAreaDomain DomainType,
+	periodStart time.Time,
+	periodEnd time.Time,
+) (*BalancingMarketDocument, error) {
+	return c.GetTotalImbalanceVolumesCtx(context.Background(), controlAreaDomain, periodStart, periodEnd)
+}
+
+// GetTotalImbalanceVolumesCtx is GetTotalImbalanceVolumes with a context.
+func (c *EntsoeClient) GetTotalImbalanceVolumesCtx(
+	ctx context.Context,
+	controlAreaDomain DomainType,
+	periodStart time.Time,
+	periodEnd time.Time,
+) (*BalancingMarketDocument, error) {
+	params := url.Values{}
+	params.Add(ParameterDocumentType, string(DocumentTypeImbalanceVolume))
+	params.Add(ParameterControlAreaDomain, string(controlAreaDomain))
+	params.Add(ParameterPeriodStart, periodStart.UTC().Format("200601021504"))
+	params.Add(ParameterPeriodEnd, periodEnd.UTC().Format("200601021504"))
+	return c.requestBalancingMarketDocument(ctx, params)
+}
+
+// 4.6.12. Financial Expenses and Income for Balancing [17.1.I]
+func (c *EntsoeClient) GetFinancialExpensesAndIncomeForBalancing(
+	controlAreaDomain DomainType,
+	periodStart time.Time,
+	periodEnd time.Time,
+) (*BalancingMarketDocument, error) {
+	return c.GetFinancialExpensesAndIncomeForBalancingCtx(context.Background(), controlAreaDomain, periodStart, periodEnd)
+}
+
+// GetFinancialExpensesAndIncomeForBalancingCtx is GetFinancialExpensesAndIncomeForBalancing with a context.
+func (c *EntsoeClient) GetFinancialExpensesAndIncomeForBalancingCtx(
+	ctx context.Context,
+	controlAreaDomain DomainType,
+	periodStart time.Time,
+	periodEnd time.Time,
+) (*BalancingMarketDocument, error) {
+	params := url.Values{}
+	params.Add(ParameterDocumentType, string(DocumentTypeFinancialSituation))
+	params.Add(ParameterControlAreaDomain, string(controlAreaDomain))
+	params.Add(ParameterPeriodStart, periodStart.UTC().Format("200601021504"))
+	params.Add(ParameterPeriodEnd, periodEnd.UTC().Format("200601021504"))
+	return c.requestBalancingMarketDocument(ctx, params)
+}
+
+// 4.6.13. Cross-border Balancing [17.1.J]
+func (c *EntsoeClient) GetCrossBorderBalancing(
+	acquiringDomain DomainType,
+	connectingDomain DomainType,
+	periodStart time.Time,
+	periodEnd time.Time,
+) (*BalancingMarketDocument, error) {
+	return c.GetCrossBorderBalancingCtx(context.Background(), acquiringDomain, connectingDomain, periodStart, periodEnd)
+}
+
+// GetCrossBorderBalancingCtx is GetCrossBorderBalancing with a context.
+func (c *EntsoeClient) GetCrossBorderBalancingCtx(
+	ctx context.Context,
+	acquiringDomain DomainType,
+	connectingDomain DomainType,
+	periodStart time.Time,
+	periodEnd time.Time,
+) (*BalancingMarketDocument, error) {
+	params := url.Values{}
+	params.Add(ParameterDocumentType, string(DocumentTypeCrossBorderBalancing))
+	params.Add(ParameterAcquiringDomain, string(acquiringDomain))
+	params.Add(ParameterConnectingDomain, string(connectingDomain))
+	params.Add(ParameterPeriodStart, periodStart.UTC().Format("200601021504"))
+	params.Add(ParameterPeriodEnd, periodEnd.UTC().Format("200601021504"))
+	return c.requestBalancingMarketDocument(ctx, params)
+}
+
+// 4.6.14. FCR Total Capacity [SO GL 187.2]
+func (c *EntsoeClient) GetFCRTotalCapacity(
+	areaDomain DomainType,
+	periodStart time.Time,
+	periodEnd time.Time,
+) (*BalancingMarketDocument, error) {
+	return c.GetFCRTotalCapacityCtx(context.Background(), areaDomain, periodStart, periodEnd)
+}
+
+// GetFCRTotalCapacityCtx is GetFCRTotalCapacity with a context.
+func (c *EntsoeClient) GetFCRTotalCapacityCtx(
+	ctx context.Context,
+	areaDomain DomainType,
+	periodStart time.Time,
+	periodEnd time.Time,
+) (*BalancingMarketDocument, error) {
+	params := url.Values{}
+	params.Add(ParameterDocumentType, string(DocumentTypeCapacityDocument))
+	params.Add(ParameterBusinessType, string(BusinessTypeGeneralCapacityInformation))
+	params.Add(ParameterAreaDomain, string(areaDomain))
+	params.Add(ParameterPeriodStart, periodStart.UTC().Format("200601021504"))
+	params.Add(ParameterPeriodEnd, periodEnd.UTC().Format("200601021504"))
+	return c.requestBalancingMarketDocument(ctx, params)
+}
+
+// 4.6.15. Shares of FCR Capacity - Share of Capacity [SO GL 187.2]
+func (c *EntsoeClient) GetShareOfFCRCapacity(
+	areaDomain DomainType,
+	periodStart time.Time,
+	periodEnd time.Time,
+) (*BalancingMarketDocument, error) {
+	return c.GetShareOfFCRCapacityCtx(context.Background(), areaDomain, periodStart, periodEnd)
+}
+
+// GetShareOfFCRCapacityCtx is GetShareOfFCRCapacity with a context.
+func (c *EntsoeClient) GetShareOfFCRCapacityCtx(
+	ctx context.Context,
+	areaDomain DomainType,
+	periodStart time.Time,
+	periodEnd time.Time,
+) (*BalancingMarketDocument, error) {
+	params := url.Values{}
+	params.Add(ParameterDocumentType, string(DocumentTypeCapacityDocument))
+	params.Add(ParameterBusinessType, string(BusinessTypeShareOfReserveCapacity))
+	params.Add(ParameterAreaDomain, string(areaDomain))
+	params.Add(ParameterPeriodStart, periodStart.UTC().Format("200601021504"))
+	params.Add(ParameterPeriodEnd, periodEnd.UTC().Format("200601021504"))
+	return c.requestBalancingMarketDocument(ctx, params)
+}
+
+// 4.6.16. Shares of FCR Capacity - Contracted Reserve Capacity [SO GL 187.2]
+func (c *EntsoeClient) GetFCRContractedReserveCapacity(
+	areaDomain DomainType,
+	periodStart time.Time,
+	periodEnd time.Time,
+) (*BalancingMarketDocument, error) {
+	return c.GetFCRContractedReserveCapacityCtx(context.Background(), areaDomain, periodStart, periodEnd)
+}
+
+// GetFCRContractedReserveCapacityCtx is GetFCRContractedReserveCapacity with a context.
+func (c *EntsoeClient) GetFCRContractedReserveCapacityCtx(
+	ctx context.Context,
+	areaDomain DomainType,
+	periodStart time.Time,
+	periodEnd time.Time,
+) (*BalancingMarketDocument, error) {
+	params := url.Values{}
+	params.Add(ParameterDocumentType, string(DocumentTypeCapacityDocument))
+	params.Add(ParameterBusinessType, string(BusinessTypeProcuredCapacity))
+	params.Add(ParameterAreaDomain, string(areaDomain))
+	params.Add(ParameterPeriodStart, periodStart.UTC().Format("200601021504"))
+	params.Add(ParameterPeriodEnd, periodEnd.UTC().Format("200601021504"))
+	return c.requestBalancingMarketDocument(ctx, params)
+}
+
+// 4.6.17. FRR Actual Capacity [SO GL 188.4]
+func (c *EntsoeClient) GetFRRActualCapacity(
+	areaDomain DomainType,
+	periodStart time.Time,
+	periodEnd time.Time,
+) (*BalancingMarketDocument, error) {
+	return c.GetFRRActualCapacityCtx(context.Background(), areaDomain, periodStart, periodEnd)
+}
+
+// GetFRRActualCapacityCtx is GetFRRActualCapacity with a context.
+func (c *EntsoeClient) GetFRRActualCapacityCtx(
+	ctx context.Context,
+	areaDomain DomainType,
+	periodStart time.Time,
+	periodEnd time.Time,
+) (*BalancingMarketDocument, error) {
+	params := url.Values{}
+	params.Add(ParameterDocumentType, string(DocumentTypeCapacityDocument))
+	params.Add(ParameterProcessType, string(ProcessTypeFrequencyRestorationReserve))
+	params.Add(ParameterBusinessType, string(BusinessTypeActualReserveCapacity))
+	params.Add(ParameterAreaDomain, string(areaDomain))
+	params.Add(ParameterPeriodStart, periodStart.UTC().Format("200601021504"))
+	params.Add(ParameterPeriodEnd, periodEnd.UTC().Format("200601021504"))
+	return c.requestBalancingMarketDocument(ctx, params)
+}
+
+// 4.6.18. RR Actual Capacity [SO GL 189.3]
+func (c *EntsoeClient) GetRRActualCapacity(
+	areaDomain DomainType,
+	periodStart time.Time,
+	periodEnd time.Time,
+) (*BalancingMarketDocument, error) {
+	return c.GetRRActualCapacityCtx(context.Background(), areaDomain, periodStart, periodEnd)
+}
+
+// GetRRActualCapacityCtx is GetRRActualCapacity with a context.
+func (c *EntsoeClient) GetRRActualCapacityCtx(
+	ctx context.Context,
+	areaDomain DomainType,
+	periodStart time.Time,
+	periodEnd time.Time,
+) (*BalancingMarketDocument, error) {
+	params := url.Values{}
+	params.Add(ParameterDocumentType, string(DocumentTypeCapacityDocument))
+	params.Add(ParameterProcessType, string(ProcessTypeReplacementReserve))
+	params.Add(ParameterBusinessType, string(BusinessTypeActualReserveCapacity))
+	params.Add(ParameterAreaDomain, string(areaDomain))
+	params.Add(ParameterPeriodStart, periodStart.UTC().Format("200601021504"))
+	params.Add(ParameterPeriodEnd, periodEnd.UTC().Format("200601021504"))
+	return c.requestBalancingMarketDocument(ctx, params)
+}
+
+// 4.6.19. Sharing of RR and FRR [SO GL 190.1]
+func (c *EntsoeClient) GetSharingOfRRAndFRR(
+	processType ProcessType,
+	acquiringDomain DomainType,
+	connectingDomain DomainType,
+	periodStart time.Time,
+	periodEnd time.Time,
+) (*BalancingMarketDocument, error) {
+	return c.GetSharingOfRRAndFRRCtx(context.Background(), processType, acquiringDomain, connectingDomain, periodStart, periodEnd)
+}
+
+// GetSharingOfRRAndFRRCtx is GetSharingOfRRAndFRR with a context.
+func (c *EntsoeClient) GetSharingOfRRAndFRRCtx(
+	ctx context.Context,
+	processType ProcessType,
+	acquiringDomain DomainType,
+	connectingDomain DomainType,
+	periodStart time.Time,
+	periodEnd time.Time,
+) (*BalancingMarketDocument, error) {
+	params := url.Values{}
+	params.Add(ParameterDocumentType, string(DocumentTypeCapacityDocument))
+	params.Add(ParameterProcessType, string(processType))
+	params.Add(ParameterBusinessType, string(BusinessTypeSharedBalancingReserveCapacity))
+	params.Add(ParameterAcquiringDomain, string(acquiringDomain))
+	params.Add(ParameterConnectingDomain, string(connectingDomain))
+	params.Add(ParameterPeriodStart, periodStart.UTC().Format("200601021504"))
+	params.Add(ParameterPeriodEnd, periodEnd.UTC().Format("200601021504"))
+	return c.requestBalancingMarketDocument(ctx, params)
+}
+
+// 4.7. Outages domain
+
+// 4.7.1. Unavailability of Consumption Units [7.1A&B]
+func (c *EntsoeClient) GetUnavailabilityOfConsumptionUnits(
+	biddingZoneDomain DomainType,
+	periodStart time.Time,
+	periodEnd time.Time,
+	businessType *BusinessType,
+	docStatus *DocStatus,
+	offset *int,
+) ([]UnavailabilityMarketDocument, error) {
+	return c.GetUnavailabilityOfConsumptionUnitsCtx(context.Background(), biddingZoneDomain, periodStart, periodEnd, businessType, docStatus, offset)
+}
+
+// GetUnavailabilityOfConsumptionUnitsCtx is GetUnavailabilityOfConsumptionUnits with a context.
+func (c *EntsoeClient) GetUnavailabilityOfConsumptionUnitsCtx(
+	ctx context.Context,
+	biddingZoneDomain DomainType,
+	periodStart time.Time,
+	periodEnd time.Time,
+	businessType *BusinessType,
+	docStatus *DocStatus,
+	offset *int,
+) ([]UnavailabilityMarketDocument, error) {
+	params := url.Values{}
+	params.Add(ParameterDocumentType, string(DocumentTypeLoadUnavailability))
+	params.Add(ParameterBiddingZoneDomain, string(biddingZoneDomain))
+	params.Add(ParameterPeriodStart, periodStart.UTC().Format("200601021504"))
+	params.Add(ParameterPeriodEnd, periodEnd.UTC().Format("200601021504"))
+	if businessType != nil {
+		params.Add(ParameterBusinessType, string(*businessType))
+	}
+	if docStatus != nil {
+		params.Add(ParameterDocStatus, string(*docStatus))
+	}
+	if offset != nil {
+		params.Add(ParameterOffset, strconv.Itoa(*offset))
+	}
+	return c.requestUnavailabilityMarketDocuments(ctx, params)
+}
+
+// 4.7.2. Unavailability of Transmission Infrastructure [10.1.A&B]
+func (c *EntsoeClient) GetUnavailabilityOfTransmissionInfrastructure(
+	inDomain DomainType,
+	outDomain DomainType,
+	periodStart time.Time,
+	periodEnd time.Time,
+	businessType *BusinessType,
+	docStatus *DocStatus,
+	offset *int,
+) ([]UnavailabilityMarketDocument, error) {
+	return c.GetUnavailabilityOfTransmissionInfrastructureCtx(context.Background(), inDomain, outDomain, periodStart, periodEnd, businessType, docStatus, offset)
+}
+
+// GetUnavailabilityOfTransmissionInfrastructureCtx is GetUnavailabilityOfTransmissionInfrastructure with a context.
+func (c *EntsoeClient) GetUnavailabilityOfTransmissionInfrastructureCtx(
+	ctx context.Context,
+	inDomain DomainType,
+	outDomain DomainType,
+	periodStart time.Time,
+	periodEnd time.Time,
+	businessType *BusinessType,
+	docStatus *DocStatus,
+	offset *int,
+) ([]UnavailabilityMarketDocument, error) {
+	params := url.Values{}
+	params.Add(ParameterDocumentType, string(DocumentTypeTransmissionUnavailability))
+	params.Add(ParameterInDomain, string(inDomain))
+	params.Add(ParameterOutDomain, string(outDomain))
+	params.Add(ParameterPeriodStart, periodStart.UTC().Format("200601021504"))
+	params.Add(ParameterPeriodEnd, periodEnd.UTC().Format("200601021504"))
+	if businessType != nil {
+		params.Add(ParameterBusinessType, string(*businessType))
+	}
+	if docStatus != nil {
+		params.Add(ParameterDocStatus, string(*docStatus))
+	}
+	if offset != nil {
+		params.Add(ParameterOffset, strconv.Itoa(*offset))
+	}
+	return c.requestUnavailabilityMarketDocuments(ctx, params)
+}
+
+// 4.7.3. Unavailability of Offshore Grid Infrastructure [10.1.C]
+func (c *EntsoeClient) GetUnavailabilityOfOffshoreGridInfrastructure(
+	biddingZoneDomain DomainType,
+	periodStart time.Time,
+	periodEnd time.Time,
+	docStatus *DocStatus,
+	offset *int,
+) ([]UnavailabilityMarketDocument, error) {
+	return c.GetUnavailabilityOfOffshoreGridInfrastructureCtx(context.Background(), biddingZoneDomain, periodStart, periodEnd, docStatus, offset)
+}
+
+// GetUnavailabilityOfOffshoreGridInfrastructureCtx is GetUnavailabilityOfOffshoreGridInfrastructure with a context.
+func (c *EntsoeClient) GetUnavailabilityOfOffshoreGridInfrastructureCtx(
+	ctx context.Context,
+	biddingZoneDomain DomainType,
+	periodStart time.Time,
+	periodEnd time.Time,
+	docStatus *DocStatus,
+	offset *int,
+) ([]UnavailabilityMarketDocument, error) {
+	params := url.Values{}
+	params.Add(ParameterDocumentType, string(DocumentTypeOffshoreGridInfrastructureUnavailability))
+	params.Add(ParameterBiddingZoneDomain, string(biddingZoneDomain))
+	params.Add(ParameterPeriodStart, periodStart.UTC().Format("200601021504"))
+	params.Add(ParameterPeriodEnd, periodEnd.UTC().Format("200601021504"))
+	if docStatus != nil {
+		params.Add(ParameterDocStatus, string(*docStatus))
+	}
+	if offset != nil {
+		params.Add(ParameterOffset, strconv.Itoa(*offset))
+	}
+	return c.requestUnavailabilityMarketDocuments(ctx, params)
+}
+
+// 4.7.4. Unavailability of Generation Units [15.1.A&B]
+func (c *EntsoeClient) GetUnavailabilityOfGenerationUnits(
+	biddingZoneDomain DomainType,
+	periodStart time.Time,
+	periodEnd time.Time,
+	businessType *BusinessType,
+	docStatus *DocStatus,
+	registeredResource *string,
+	offset *int,
+) ([]UnavailabilityMarketDocument, error) {
+	return c.GetUnavailabilityOfGenerationUnitsCtx(context.Background(), biddingZoneDomain, periodStart, periodEnd, businessType, docStatus, registeredResource, offset)
+}
+
+// GetUnavailabilityOfGenerationUnitsCtx is GetUnavailabilityOfGenerationUnits with a context.
+func (c *EntsoeClient) GetUnavailabilityOfGenerationUnitsCtx(
+	ctx context.Context,
+	biddingZoneDomain DomainType,
+	periodStart time.Time,
+	periodEnd time.Time,
+	businessType *BusinessType,
+	docStatus *DocStatus,
+	registeredResource *string,
+	offset *int,
+) ([]UnavailabilityMarketDocument, error) {
+	params := url.Values{}
+	params.Add(ParameterDocumentType, string(DocumentTypeGenerationUnavailability))
+	params.Add(ParameterBiddingZoneDomain, string(biddingZoneDomain))
+	params.Add(ParameterPeriodStart, periodStart.UTC().Format("200601021504"))
+	params.Add(ParameterPeriodEnd, periodEnd.UTC().Format("200601021504"))
+	if businessType != nil {
+		params.Add(ParameterBusinessType, string(*businessType))
+	}
+	if docStatus != nil {
+		params.Add(ParameterDocStatus, string(*docStatus))
+	}
+	if registeredResource != nil {
+		params.Add(ParameterRegisteredResource, *registeredResource)
+	}
+	if offset != nil {
+		params.Add(ParameterOffset, strconv.Itoa(*offset))
+	}
+	return c.requestUnavailabilityMarketDocuments(ctx, params)
+}
+
+// 4.7.5. Unavailability of Production Units [15.1.C&D]
+func (c *EntsoeClient) GetUnavailabilityOfProductionUnits(
+	biddingZoneDomain DomainType,
+	periodStart time.Time,
+	periodEnd time.Time,
+	businessType *BusinessType,
+	docStatus *DocStatus,
+	registeredResource *string,
+	offset *int,
+) ([]UnavailabilityMarketDocument, error) {
+	return c.GetUnavailabilityOfProductionUnitsCtx(context.Background(), biddingZoneDomain, periodStart, periodEnd, businessType, docStatus, registeredResource, offset)
+}
+
+// GetUnavailabilityOfProductionUnitsCtx is GetUnavailabilityOfProductionUnits with a context.
+func (c *EntsoeClient) GetUnavailabilityOfProductionUnitsCtx(
+	ctx context.Context,
+	biddingZoneDomain DomainType,
+	periodStart time.Time,
+	periodEnd time.Time,
+	businessType *BusinessType,
+	docStatus *DocStatus,
+	registeredResource *string,
+	offset *int,
+) ([]UnavailabilityMarketDocument, error) {
+	params := url.Values{}
+	params.Add(ParameterDocumentType, string(DocumentTypeProductionUnavailability))
+	params.Add(ParameterBiddingZoneDomain, string(biddingZoneDomain))
+	params.Add(ParameterPeriodStart, periodStart.UTC().Format("200601021504"))
+	params.Add(ParameterPeriodEnd, periodEnd.UTC().Format("200601021504"))
+	if businessType != nil {
+		params.Add(ParameterBusinessType, string(*businessType))
+	}
+	if docStatus != nil {
+		params.Add(ParameterDocStatus, string(*docStatus))
+	}
+	if registeredResource != nil {
+		params.Add(ParameterRegisteredResource, *registeredResource)
+	}
+	if offset != nil {
+		params.Add(ParameterOffset, strconv.Itoa(*offset))
+	}
+	return c.requestUnavailabilityMarketDocuments(ctx, params)
+}
+
+func (c *EntsoeClient) requestGLMarketDocument(ctx context.Context, params url.Values) (*GLMarketDocument, error) {
+	doc, err := c.requestDocument(ctx, params)
 	if err != nil {
-		fmt.Println(string(data))
 		return nil, err
 	}
-	return &doc, nil
+	res, ok := doc.(*GLMarketDocument)
+	if !ok {
+		return nil, unexpectedDocumentError(doc)
+	}
+	return res, nil
 }
 
-func (c *EntsoeClient) requestPublicationMarketDocument(params url.Values) (*PublicationMarketDocument, error) {
-	paramStr := params.Encode()
-	data, err := c.sendRequest(paramStr)
+func (c *EntsoeClient) requestTransmissionNetworkMarketDocument(ctx context.Context, params url.Values) (*TransmissionNetworkMarketDocument, error) {
+	doc, err := c.requestDocument(ctx, params)
 	if err != nil {
 		return nil, err
 	}
+	res, ok := doc.(*TransmissionNetworkMarketDocument)
+	if !ok {
+		return nil, unexpectedDocumentError(doc)
+	}
+	return res, nil
+}
 
-	var doc PublicationMarketDocument
-	err = xml.Unmarshal(data, &doc)
+func (c *EntsoeClient) requestPublicationMarketDocument(ctx context.Context, params url.Values) (*PublicationMarketDocument, error) {
+	doc, err := c.requestDocument(ctx, params)
 	if err != nil {
-		fmt.Println(string(data))
 		return nil, err
 	}
-	return &doc, nil
+	res, ok := doc.(*PublicationMarketDocument)
+	if !ok {
+		return nil, unexpectedDocumentError(doc)
+	}
+	return res, nil
 }
 
-func (c *EntsoeClient) requestCriticalNetworkElementMarketDocument(params url.Values) (*CriticalNetworkElementMarketDocument, error) {
-	paramStr := params.Encode()
-	data, err := c.sendRequest(paramStr)
+func (c *EntsoeClient) requestCriticalNetworkElementMarketDocument(ctx context.Context, params url.Values) (*CriticalNetworkElementMarketDocument, error) {
+	doc, err := c.requestDocument(ctx, params)
 	if err != nil {
 		return nil, err
 	}
+	res, ok := doc.(*CriticalNetworkElementMarketDocument)
+	if !ok {
+		return nil, unexpectedDocumentError(doc)
+	}
+	return res, nil
+}
 
-	var doc CriticalNetworkElementMarketDocument
-	err = xml.Unmarshal(data, &doc)
+func (c *EntsoeClient) requestBalancingMarketDocument(ctx context.Context, params url.Values) (*BalancingMarketDocument, error) {
+	doc, err := c.requestDocument(ctx, params)
 	if err != nil {
-		fmt.Println(string(data))
 		return nil, err
 	}
-	return &doc, nil
+	res, ok := doc.(*BalancingMarketDocument)
+	if !ok {
+		return nil, unexpectedDocumentError(doc)
+	}
+	return res, nil
 }
 
-func (c *EntsoeClient) sendRequest(paramStr string) ([]byte, error) {
-	resp, err := http.Get("https://transparency.entsoe.eu/api?securityToken=" + c.apiKey + "&" + paramStr)
+func (c *EntsoeClient) requestConfigurationMarketDocument(ctx context.Context, params url.Values) (*ConfigurationMarketDocument, error) {
+	doc, err := c.fetchDocument(ctx, params)
 	if err != nil {
 		return nil, err
 	}
-	body := resp.Body
-	defer body.Close()
-	bodyBytes, err := ioutil.ReadAll(body)
+	res, ok := doc.(*ConfigurationMarketDocument)
+	if !ok {
+		return nil, unexpectedDocumentError(doc)
+	}
+	return res, nil
+}
+
+func (c *EntsoeClient) requestUnavailabilityMarketDocuments(ctx context.Context, params url.Values) ([]UnavailabilityMarketDocument, error) {
+	windows := c.splitPeriod(params)
+	docs := make([][]UnavailabilityMarketDocument, len(windows))
+	err := c.forEachWindow(ctx, len(windows), func(ctx context.Context, i int) error {
+		var err error
+		docs[i], err = c.fetchUnavailabilityPages(ctx, windows[i])
+		return err
+	})
 	if err != nil {
 		return nil, err
 	}
-	return bodyBytes, nil
+
+	var res []UnavailabilityMarketDocument
+	for _, d := range docs {
+		res = append(res, d...)
+	}
+	return res, nil
 }
 
-func (c *EntsoeClient) ConvertGlMarketDocument2Map(r *GLMarketDocument) map[time.Time]int {
+// fetchUnavailabilityPages fetches all documents of a window, requesting
+// further pages with an increasing offset while pages are full. A window
+// with an offset set by the caller is fetched as a single page.
+func (c *EntsoeClient) fetchUnavailabilityPages(ctx context.Context, params url.Values) ([]UnavailabilityMarketDocument, error) {
+	if params.Get(ParameterOffset) != "" {
+		return c.fetchUnavailabilityMarketDocuments(ctx, params)
+	}
+	var res []UnavailabilityMarketDocument
+	for {
+		page, err := c.fetchUnavailabilityMarketDocuments(ctx, params)
+		if errors.Is(err, ErrNoMatchingData) && len(res) > 0 {
+			return res, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, page...)
+		if len(page) < maxDocumentsPerPage {
+			return res, nil
+		}
+		next := url.Values{}
+		for k, v := range params {
+			next[k] = v
+		}
+		next.Set(ParameterOffset, strconv.Itoa(len(res)))
+		params = next
+	}
+}
 
-	res := make(map[time.Time]int)
+// fetchUnavailabilityMarketDocuments handles the outage endpoints, which
+// answer with a zip archive holding one XML file per outage document.
+func (c *EntsoeClient) fetchUnavailabilityMarketDocuments(ctx context.Context, params url.Values) ([]UnavailabilityMarketDocument, error) {
+	doc, err := c.fetchDocument(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	docs, ok := doc.(Documents)
+	if !ok {
+		docs = Documents{doc}
+	}
+	res := make([]UnavailabilityMarketDocument, 0, len(docs))
+	for _, d := range docs {
+		u, ok := d.(*UnavailabilityMarketDocument)
+		if !ok {
+			return nil, unexpectedDocumentError(d)
+		}
+		res = append(res, *u)
+	}
+	return res, nil
+}
 
-	for _, timeSeries := range r.TimeSeries {
+// requestDocument fetches the document for every window of the requested
+// period and merges them into one.
+func (c *EntsoeClient) requestDocument(ctx context.Context, params url.Values) (Document, error) {
+	windows := c.splitPeriod(params)
+	docs := make([]Document, len(windows))
+	err := c.forEachWindow(ctx, len(windows), func(ctx context.Context, i int) error {
+		doc, err := c.fetchDocument(ctx, windows[i])
+		docs[i] = doc
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
 
-		period := timeSeries.Period
+	var res Document
+	for _, doc := range docs {
+		if doc == nil {
+			continue
+		}
+		if res == nil {
+			res = doc
+			continue
+		}
+		m, ok := res.(mergeableDocument)
+		if !ok || !m.merge(doc) {
+			return nil, fmt.Errorf("entsoe: cannot merge %T into %T", doc, res)
+		}
+	}
+	return res, nil
+}
 
-		timeIntervalStart, err := time.Parse("2006-01-02T15:04Z", period.TimeInterval.Start)
-		if err != nil {
-			log.Fatal(err)
+// fetchDocument sends a single request and decodes the response.
+func (c *EntsoeClient) fetchDocument(ctx context.Context, params url.Values) (Document, error) {
+	data, err := c.sendRequest(ctx, params.Encode())
+	if err != nil {
+		return nil, err
+	}
+	doc, err := decode(data)
+	if err != nil {
+		c.logDecodeError(data, err)
+		return nil, err
+	}
+	return doc, nil
+}
+
+// sendRequest performs the request, retrying it as configured by the retry
+// policy, and returns the response body.
+func (c *EntsoeClient) sendRequest(ctx context.Context, paramStr string) ([]byte, error) {
+	var bodyBytes []byte
+	err := c.retry(ctx, func() (http.Header, error) {
+		var header http.Header
+		var err error
+		bodyBytes, header, err = c.doRequest(ctx, paramStr)
+		return header, err
+	})
+	return bodyBytes, err
+}
+
+// retry calls attempt until it succeeds or the retry policy gives up.
+// attempt returns the response header alongside errors so that Retry-After
+// can be honoured.
+func (c *EntsoeClient) retry(ctx context.Context, attemptFn func() (http.Header, error)) error {
+	for attempt := 1; ; attempt++ {
+		header, err := attemptFn()
+		if err == nil {
+			return nil
 		}
 
-		t := timeIntervalStart
-		points := period.Point
-		for _, point := range points {
-			quantity, _ := strconv.Atoi(point.Quantity)
-			value := res[t]
-			res[t] = getMaxInt(value, quantity)
-
-			switch period.Resolution {
-			case "PT15M":
-				t = t.Add(15 * time.Minute)
-			case "PT30M":
-				t = t.Add(30 * time.Minute)
-			case "PT60M":
-				t = t.Add(60 * time.Minute)
-			case "P1D":
-				t = t.AddDate(0, 0, 1)
-			case "P7D":
-				t = t.AddDate(0, 0, 7)
-			case "P1Y":
-				t = t.AddDate(1, 0, 0)
-			}
+		statusCode := 0
+		var apiErr *APIError
+		if errors.As(err, &apiErr) {
+			statusCode = apiErr.StatusCode
+		}
+		if attempt >= c.retryPolicy.MaxAttempts || ctx.Err() != nil || !c.retryPolicy.shouldRetry(statusCode, err) {
+			return err
 		}
-	}
 
-	return res
+		delay := c.retryPolicy.delay(attempt, header)
+		c.logger.Warn("entsoe: retrying request", "attempt", attempt, "status", statusCode, "delay", delay, "err", err)
+		if c.retryPolicy.OnAttempt != nil {
+			c.retryPolicy.OnAttempt(RetryAttempt{
+				Attempt:    attempt,
+				StatusCode: statusCode,
+				Err:        err,
+				Delay:      delay,
+			})
+		}
+		if err := sleep(ctx, delay); err != nil {
+			return err
+		}
+	}
 }
 
-func (c *EntsoeClient) PopulateMap(r *GLMarketDocument, skipMode bool, res map[time.Time]int) {
+// doRequest performs a single attempt of the request and reads the response
+// body.
+func (c *EntsoeClient) doRequest(ctx context.Context, paramStr string) ([]byte, http.Header, error) {
+	resp, err := c.openRequest(ctx, paramStr)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer resp.Body.Close()
+	bodyBytes, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, resp.Header, err
+	}
+	if resp.StatusCode != http.StatusOK || isAcknowledgement(bodyBytes) {
+		return nil, resp.Header, newAPIError(resp.StatusCode, bodyBytes)
+	}
+	return bodyBytes, resp.Header, nil
+}
 
-	for _, timeSeries := range r.TimeSeries {
-		if skipMode && timeSeries.InBiddingZoneDomainMRID.Text == "" {
-			continue
+// openRequest performs a single attempt of the request and returns the
+// response with its body unread. The per-request timeout lasts until the body
+// is closed.
+func (c *EntsoeClient) openRequest(ctx context.Context, paramStr string) (*http.Response, error) {
+	if c.rateLimiter != nil {
+		if err := c.rateLimiter.Wait(ctx); err != nil {
+			return nil, err
+		}
+	}
+	cancel := context.CancelFunc(func() {})
+	if c.timeout > 0 {
+		ctx, cancel = context.WithTimeout(ctx, c.timeout)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.baseURL+"?securityToken="+c.apiKey+"&"+paramStr, nil)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	if c.userAgent != "" {
+		req.Header.Set("User-Agent", c.userAgent)
+	}
+	redactedURL := c.baseURL + "?securityToken=REDACTED&" + paramStr
+	started := time.Now()
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		cancel()
+		// The url.Error returned by Do carries the URL including the token.
+		var urlErr *url.Error
+		if errors.As(err, &urlErr) {
+			urlErr.URL = redactedURL
 		}
+		c.logger.Debug("entsoe: request failed", "url", redactedURL, "latency", time.Since(started), "err", err)
+		return nil, err
+	}
+	resp.Body = &responseBody{
+		ReadCloser: resp.Body,
+		onClose: func(size int64) {
+			cancel()
+			c.logger.Debug("entsoe: request done", "url", redactedURL, "status", resp.StatusCode, "latency", time.Since(started), "size", size)
+		},
+	}
+	return resp, nil
+}
+
+// responseBody counts the bytes read from a response body and reports them
+// when the body is closed.
+type responseBody struct {
+	io.ReadCloser
+	size    int64
+	onClose func(size int64)
+}
+
+func (b *responseBody) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+	b.size += int64(n)
+	return n, err
+}
 
-		period := timeSeries.Period
+func (b *responseBody) Close() error {
+	err := b.ReadCloser.Close()
+	if b.onClose != nil {
+		b.onClose(b.size)
+		b.onClose = nil
+	}
+	return err
+}
 
-		timeIntervalStart, err := time.Parse("2006-01-02T15:04Z", period.TimeInterval.Start)
+// ConvertGlMarketDocument2Map returns the quantities of doc by time. Where
+// several time series cover the same time the largest quantity wins.
+// Quantities are rounded to the nearest integer, so the map is lossy for
+// fractional values; use ConvertGLMarketDocument and Point.Value or Point.Rat
+// for exact quantities.
+func ConvertGlMarketDocument2Map(doc *GLMarketDocument) (map[time.Time]int, error) {
+	res := make(map[time.Time]int)
+	for i, period := range glRawPeriods(doc) {
+		points, _, err := period.expand()
 		if err != nil {
-			log.Fatal(err)
+			return nil, fmt.Errorf("time series %s: %w", doc.TimeSeries[i].MRID, err)
 		}
+		for _, point := range points {
+			quantity, err := parseQuantity(point.value)
+			if err != nil {
+				return nil, fmt.Errorf("time series %s: %w", doc.TimeSeries[i].MRID, err)
+			}
+			res[point.start] = getMaxInt(res[point.start], quantity)
+		}
+	}
+	return res, nil
+}
 
-		t := timeIntervalStart
-		points := period.Point
+// PopulateMap stores the quantities of doc by time in res. With skipMode,
+// time series without an in bidding zone are ignored. On error res may have
+// been partially populated. Like ConvertGlMarketDocument2Map it rounds
+// quantities to the nearest integer.
+func PopulateMap(doc *GLMarketDocument, skipMode bool, res map[time.Time]int) error {
+	periods := glRawPeriods(doc)
+	for i, timeSeries := range doc.TimeSeries {
+		if skipMode && timeSeries.InBiddingZoneDomainMRID.Text == "" {
+			continue
+		}
+		points, _, err := periods[i].expand()
+		if err != nil {
+			return fmt.Errorf("time series %s: %w", timeSeries.MRID, err)
+		}
 		for _, point := range points {
-			quantity, _ := strconv.ParseInt(point.Quantity, 10, 32)
-			res[t] = int(quantity)
-			switch period.Resolution {
-			case "PT15M":
-				t = t.Add(15 * time.Minute)
-			case "PT30M":
-				t = t.Add(30 * time.Minute)
-			case "PT60M":
-				t = t.Add(60 * time.Minute)
-			case "P1D":
-				t = t.AddDate(0, 0, 1)
-			case "P7D":
-				t = t.AddDate(0, 0, 7)
-			case "P1Y":
-				t = t.AddDate(1, 0, 0)
+			quantity, err := parseQuantity(point.value)
+			if err != nil {
+				return fmt.Errorf("time series %s: %w", timeSeries.MRID, err)
 			}
+			res[point.start] = quantity
 		}
 	}
+	return nil
+}
+
+// ConvertGlMarketDocument2Map is like the package level
+// ConvertGlMarketDocument2Map but exits the program on error.
+//
+// Deprecated: Use ConvertGlMarketDocument2Map or ConvertGLMarketDocument.
+func (c *EntsoeClient) ConvertGlMarketDocument2Map(r *GLMarketDocument) map[time.Time]int {
+	res, err := ConvertGlMarketDocument2Map(r)
+	if err != nil {
+		log.Fatal(err)
+	}
+	return res
+}
+
+// PopulateMap is like the package level PopulateMap but exits the program on
+// error.
+//
+// Deprecated: Use PopulateMap or ConvertGLMarketDocument.
+func (c *EntsoeClient) PopulateMap(r *GLMarketDocument, skipMode bool, res map[time.Time]int) {
+	if err := PopulateMap(r, skipMode, res); err != nil {
+		log.Fatal(err)
+	}
 }
 
 func GetSortedTimes(res map[time.Time]int) []time.Time {
@@ -1033,6 +2477,16 @@ func GetSortedTimes(res map[time.Time]int) []time.Time {
 	return timeSlice
 }
 
+// parseQuantity parses a possibly fractional quantity and rounds it to the
+// nearest integer.
+func parseQuantity(s string) (int, error) {
+	f, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid quantity %q: %w", s, err)
+	}
+	return int(math.Round(f)), nil
+}
+
 func getMaxInt(a, b int) int {
 	if b > a {
 		return b
@@ -1040,3 +2494,56 @@ func getMaxInt(a, b int) int {
 		return a
 	}
 }
+
+// isZipArchive reports whether data starts with the zip local file header
+// signature. The API sends "application/zip" for these, but sniffing the
+// body keeps the check independent of the response headers.
+func isZipArchive(data []byte) bool {
+	return bytes.HasPrefix(data, []byte("PK\x03\x04"))
+}
+
+// readZipArchive returns the content of every file in the zip archive data.
+func readZipArchive(data []byte) ([][]byte, error) {
+	zipReader, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return nil, err
+	}
+	files := make([][]byte, 0, len(zipReader.File))
+	for _, zipFile := range zipReader.File {
+		content, err := readZipFile(zipFile)
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, content)
+	}
+	return files, nil
+}
+
+func readZipFile(zf *zip.File) ([]byte, error) {
+	f, err := zf.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return ioutil.ReadAll(f)
+}
+
+// latin1ToUTF8 returns data with every byte that is not part of a valid UTF-8
+// sequence reinterpreted as an ISO 8859-1 character. Valid input is returned
+// unchanged.
+func latin1ToUTF8(data []byte) []byte {
+	if utf8.Valid(data) {
+		return data
+	}
+	res := make([]byte, 0, len(data)+len(data)/8)
+	for len(data) > 0 {
+		r, size := utf8.DecodeRune(data)
+		if r == utf8.RuneError && size == 1 {
+			res = append(res, string(rune(data[0]))...)
+		} else {
+			res = append(res, data[:size]...)
+		}
+		data = data[size:]
+	}
+	return res
+}
@@ -1,6 +1,11 @@
 package goentsoe
 
 import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/xml"
+	"errors"
 	"log"
 	"testing"
 	"time"
@@ -13,7 +18,7 @@ import (
 
 // 4.1.1. Actual Total Load [6.1.A]
 func TestGetActualTotalLoad(t *testing.T) {
-	c := NewEntsoeClientFromEnv()
+	c := newTestClient(t)
 	doc, err := c.GetActualTotalLoad(
 		DomainCZ,
 		genTime("201512312300"),
@@ -25,7 +30,7 @@ func TestGetActualTotalLoad(t *testing.T) {
 
 // 4.1.2. Day-Ahead Total Load Forecast [6.1.B]
 func TestGetDayAheadTotalLoadForecast(t *testing.T) {
-	c := NewEntsoeClientFromEnv()
+	c := newTestClient(t)
 	doc, err := c.GetDayAheadTotalLoadForecast(
 		DomainCZ,
 		genTime("201512312300"),
@@ -37,7 +42,7 @@ func TestGetDayAheadTotalLoadForecast(t *testing.T) {
 
 // 4.1.3. Week-Ahead Total Load Forecast [6.1.C]
 func TestGetWeekAheadTotalLoadForecast(t *testing.T) {
-	c := NewEntsoeClientFromEnv()
+	c := newTestClient(t)
 	doc, err := c.GetWeekAheadTotalLoadForecast(
 		DomainCZ,
 		genTime("201512312300"),
@@ -49,7 +54,7 @@ func TestGetWeekAheadTotalLoadForecast(t *testing.T) {
 
 // 4.1.4. Month-Ahead Total Load Forecast [6.1.D]
 func TestGetMonthAheadTotalLoadForecast(t *testing.T) {
-	c := NewEntsoeClientFromEnv()
+	c := newTestClient(t)
 	doc, err := c.GetMonthAheadTotalLoadForecast(
 		DomainCZ,
 		genTime("201512312300"),
@@ -61,7 +66,7 @@ func TestGetMonthAheadTotalLoadForecast(t *testing.T) {
 
 // 4.1.5. Year-Ahead Total Load Forecast [6.1.E]
 func TestGetYearAheadTotalLoadForecast(t *testing.T) {
-	c := NewEntsoeClientFromEnv()
+	c := newTestClient(t)
 	doc, err := c.GetYearAheadTotalLoadForecast(
 		DomainCZ,
 		genTime("201512312300"),
@@ -73,7 +78,7 @@ func TestGetYearAheadTotalLoadForecast(t *testing.T) {
 
 // 4.1.6. Year-Ahead Forecast Margin [8.1]
 func TestGetYearAheadForecastMargin(t *testing.T) {
-	c := NewEntsoeClientFromEnv()
+	c := newTestClient(t)
 	doc, err := c.GetYearAheadForecastMargin(
 		DomainCZ,
 		genTime("201512312300"),
@@ -89,7 +94,7 @@ func TestGetYearAheadForecastMargin(t *testing.T) {
 
 func TestGetExpansionAndDismantlingProjects(t *testing.T) {
 	t.Skip("TODO: always returns \"No matching data found for Data item Expansion and Dismantling Projects [9.1]\"")
-	c := NewEntsoeClientFromEnv()
+	c := newTestClient(t)
 	businessType := BusinessTypeInterconnectorNetworkEvolution
 	doc, err := c.GetExpansionAndDismantlingProjects(
 		DomainCZ,
@@ -105,7 +110,7 @@ func TestGetExpansionAndDismantlingProjects(t *testing.T) {
 
 // 4.2.2. Forecasted Capacity [11.1.A]
 func TestGetForecastedCapacity(t *testing.T) {
-	c := NewEntsoeClientFromEnv()
+	c := newTestClient(t)
 	doc, err := c.GetForecastedCapacity(
 		ContractMarketAgreementTypeDaily,
 		DomainCZ,
@@ -119,7 +124,7 @@ func TestGetForecastedCapacity(t *testing.T) {
 
 // 4.2.3. Offered Capacity [11.1.A]
 func TestGetOfferedCapacity(t *testing.T) {
-	c := NewEntsoeClientFromEnv()
+	c := newTestClient(t)
 	doc, err := c.GetOfferedCapacity(
 		AuctionTypeImplicit,
 		ContractMarketAgreementTypeDaily,
@@ -136,7 +141,7 @@ func TestGetOfferedCapacity(t *testing.T) {
 
 // 4.2.4. Flow-based Parameters [11.1.B]
 func TestGetFlowBasedParameters(t *testing.T) {
-	c := NewEntsoeClientFromEnv()
+	c := newTestClient(t)
 	doc, err := c.GetFlowBasedParameters(
 		ProcessTypeDayAhead,
 		"10YDOM-REGION-1V",
@@ -149,7 +154,7 @@ func TestGetFlowBasedParameters(t *testing.T) {
 
 // 4.2.5. Intraday Transfer Limits [11.3]
 func TestGetIntradayTransferLimits(t *testing.T) {
-	c := NewEntsoeClientFromEnv()
+	c := newTestClient(t)
 	doc, err := c.GetIntradayTransferLimits(
 		DomainFR,
 		DomainGB,
@@ -163,7 +168,7 @@ func TestGetIntradayTransferLimits(t *testing.T) {
 // 4.2.6. Explicit Allocation Information (Capacity) [12.1.A]
 func TestExplicitAllocationInformationCapacity(t *testing.T) {
 	t.Skip("TODO: always returns \"No matching data found for Data item Explicit Allocations\"")
-	c := NewEntsoeClientFromEnv()
+	c := newTestClient(t)
 	auctionCategory := AuctionCategoryBase
 	doc, err := c.GetExplicitAllocationInformation(
 		BusinessTypeCapacityAllocated,
@@ -181,7 +186,7 @@ func TestExplicitAllocationInformationCapacity(t *testing.T) {
 
 // 4.2.7. Explicit Allocation Information (Revenue only) [12.1.A]
 func TestExplicitAllocationInformationRevenueOnly(t *testing.T) {
-	c := NewEntsoeClientFromEnv()
+	c := newTestClient(t)
 	doc, err := c.GetExplicitAllocationInformation(
 		BusinessTypeAuctionRevenue,
 		ContractMarketAgreementTypeDaily,
@@ -198,7 +203,7 @@ func TestExplicitAllocationInformationRevenueOnly(t *testing.T) {
 
 // 4.2.8. Total Capacity Nominated [12.1.B]
 func TestGetTotalCapacityNominated(t *testing.T) {
-	c := NewEntsoeClientFromEnv()
+	c := newTestClient(t)
 	doc, err := c.GetTotalCapacityNominated(
 		BusinessTypeTotalNominatedCapacity,
 		DomainCZ,
@@ -213,7 +218,7 @@ func TestGetTotalCapacityNominated(t *testing.T) {
 // 4.2.9. Total Capacity Already Allocated [12.1.C]
 func TestGetTotalCapacityAlreadyAllocated(t *testing.T) {
 	t.Skip("TODO: always returns \"The combination of [DocumentType=A26,BusinessType=A29] is not valid, or the requested data is not allowed to be fetched via this service.\"")
-	c := NewEntsoeClientFromEnv()
+	c := newTestClient(t)
 	doc, err := c.GetTotalCapacityAlreadyAllocated(
 		BusinessTypeAlreadyAllocatedCapacity,
 		ContractMarketAgreementTypeIntraday,
@@ -229,7 +234,7 @@ func TestGetTotalCapacityAlreadyAllocated(t *testing.T) {
 
 // 4.2.10. Day Ahead Prices [12.1.D]
 func TestGetDayAheadPrices(t *testing.T) {
-	c := NewEntsoeClientFromEnv()
+	c := newTestClient(t)
 	doc, err := c.GetDayAheadPrices(
 		DomainCZ,
 		genTime("201512312300"),
@@ -241,7 +246,7 @@ func TestGetDayAheadPrices(t *testing.T) {
 
 // 4.2.11. Implicit Auction — Net Positions [12.1.E]
 func TestGetImplicitAuctionNetPositions(t *testing.T) {
-	c := NewEntsoeClientFromEnv()
+	c := newTestClient(t)
 	doc, err := c.GetImplicitAuction(
 		BusinessTypeNetPosition,
 		ContractMarketAgreementTypeDaily,
@@ -255,7 +260,7 @@ func TestGetImplicitAuctionNetPositions(t *testing.T) {
 
 // 4.2.12. Implicit Auction — Congestion Income [12.1.E]
 func TestGetImplicitAuctionCongestionIncome(t *testing.T) {
-	c := NewEntsoeClientFromEnv()
+	c := newTestClient(t)
 	doc, err := c.GetImplicitAuction(
 		BusinessTypeCongestionIncome,
 		ContractMarketAgreementTypeDaily,
@@ -269,7 +274,7 @@ func TestGetImplicitAuctionCongestionIncome(t *testing.T) {
 
 // 4.2.13. Total Commercial Schedules [12.1.F]
 func TestGetTotalCommercialSchedules(t *testing.T) {
-	c := NewEntsoeClientFromEnv()
+	c := newTestClient(t)
 	doc, err := c.GetTotalCommercialSchedules(
 		DomainCZ,
 		DomainSK,
@@ -283,7 +288,7 @@ func TestGetTotalCommercialSchedules(t *testing.T) {
 
 // 4.2.14. Day-ahead Commercial Schedules [12.1.F]
 func TestGetDayAheadCommercialSchedules(t *testing.T) {
-	c := NewEntsoeClientFromEnv()
+	c := newTestClient(t)
 	doc, err := c.GetDayAheadCommercialSchedules(
 		DomainCZ,
 		DomainSK,
@@ -297,7 +302,7 @@ func TestGetDayAheadCommercialSchedules(t *testing.T) {
 
 // 4.2.15. Physical Flows [12.1.G]
 func TestGetPhysicalFlows(t *testing.T) {
-	c := NewEntsoeClientFromEnv()
+	c := newTestClient(t)
 	doc, err := c.GetPhysicalFlows(
 		DomainCZ,
 		DomainSK,
@@ -310,7 +315,7 @@ func TestGetPhysicalFlows(t *testing.T) {
 
 // 4.2.16. Capacity Allocated Outside EU [12.1.H]
 func TestGetCapacityAllocatedOutsideEu(t *testing.T) {
-	c := NewEntsoeClientFromEnv()
+	c := newTestClient(t)
 	auctionCategory := AuctionCategoryHourly
 	doc, err := c.GetCapacityAllocatedOutsideEu(
 		AuctionTypeExplicit,
@@ -331,7 +336,7 @@ func TestGetCapacityAllocatedOutsideEu(t *testing.T) {
 // 4.3.1. Redispatching [13.1.A]
 func TestGetRedispatching(t *testing.T) {
 	t.Skip("TODO: always returns \"The combination of [DocumentType=A63] is not valid, or the requested data is not allowed to be fetched via this service.\"")
-	c := NewEntsoeClientFromEnv()
+	c := newTestClient(t)
 	doc, err := c.GetRedispatching(
 		DomainCZ,
 		DomainSK,
@@ -346,7 +351,7 @@ func TestGetRedispatching(t *testing.T) {
 // 4.3.2. Countertrading [13.1.B]
 func TestGetCountertrading(t *testing.T) {
 	t.Skip("TODO: always returns \"No matching data found for Data item Countertrading [13.1.B]\"")
-	c := NewEntsoeClientFromEnv()
+	c := newTestClient(t)
 	doc, err := c.GetCountertrading(
 		DomainCZ,
 		DomainSK,
@@ -359,7 +364,7 @@ func TestGetCountertrading(t *testing.T) {
 
 // 4.3.3. Costs of Congestion Management [13.1.C]
 func TestGetCostsOfCongestionManagement(t *testing.T) {
-	c := NewEntsoeClientFromEnv()
+	c := newTestClient(t)
 	businessType := BusinessTypeCounterTrade
 	doc, err := c.GetCostsOfCongestionManagement(
 		DomainCZ,
@@ -375,7 +380,7 @@ func TestGetCostsOfCongestionManagement(t *testing.T) {
 
 // 4.4.1. Installed Generation Capacity Aggregated [14.1.A]
 func TestGetInstalledGenerationCapacityAggregated(t *testing.T) {
-	c := NewEntsoeClientFromEnv()
+	c := newTestClient(t)
 	psrType := PsrTypeSolar
 	doc, err := c.GetInstalledGenerationCapacityAggregated(
 		ProcessTypeYearAhead,
@@ -390,7 +395,7 @@ func TestGetInstalledGenerationCapacityAggregated(t *testing.T) {
 
 // 4.4.2. Installed Generation Capacity per Unit [14.1.B]
 func TestGetInstalledGenerationCapacityPerUnit(t *testing.T) {
-	c := NewEntsoeClientFromEnv()
+	c := newTestClient(t)
 	psrType := PsrTypeFossilBrownCoalLignite
 	doc, err := c.GetInstalledGenerationCapacityPerUnit(
 		ProcessTypeYearAhead,
@@ -405,7 +410,7 @@ func TestGetInstalledGenerationCapacityPerUnit(t *testing.T) {
 
 // 4.4.3. Day-ahead Aggregated Generation [14.1.C]
 func TestGetDayAheadAggregatedGeneration(t *testing.T) {
-	c := NewEntsoeClientFromEnv()
+	c := newTestClient(t)
 	doc, err := c.GetDayAheadAggregatedGeneration(
 		ProcessTypeDayAhead,
 		DomainCZ,
@@ -418,7 +423,7 @@ func TestGetDayAheadAggregatedGeneration(t *testing.T) {
 
 // 4.4.4. Day-ahead Generation Forecasts for Wind and Solar [14.1.D]
 func TestDayAheadGenerationForecastsForWindAndSolar(t *testing.T) {
-	c := NewEntsoeClientFromEnv()
+	c := newTestClient(t)
 	psrType := PsrTypeSolar
 	doc, err := c.GetGenerationForecastsForWindAndSolar(
 		ProcessTypeDayAhead,
@@ -434,7 +439,7 @@ func TestDayAheadGenerationForecastsForWindAndSolar(t *testing.T) {
 // 4.4.5. Current Generation Forecasts for Wind and Solar [14.1.D]
 func TestCurrentGenerationForecastsForWindAndSolar(t *testing.T) {
 	t.Skip("TODO: always returns \"No matching data found for Data item Current Generation Forecasts for Wind and Solar [14.1.D]\"")
-	c := NewEntsoeClientFromEnv()
+	c := newTestClient(t)
 	psrType := PsrTypeSolar
 	doc, err := c.GetGenerationForecastsForWindAndSolar(
 		ProcessTypeIntradayTotal,
@@ -450,7 +455,7 @@ func TestCurrentGenerationForecastsForWindAndSolar(t *testing.T) {
 // 4.4.6. Intraday Generation Forecasts for Wind and Solar [14.1.D]
 func TestIntradayGenerationForecastsForWindAndSolar(t *testing.T) {
 	t.Skip("TODO: always returns \"No matching data found for Data item Current Generation Forecasts for Wind and Solar [14.1.D]\"")
-	c := NewEntsoeClientFromEnv()
+	c := newTestClient(t)
 	psrType := PsrTypeSolar
 	doc, err := c.GetGenerationForecastsForWindAndSolar(
 		ProcessTypeIntradayProcess,
@@ -465,7 +470,7 @@ func TestIntradayGenerationForecastsForWindAndSolar(t *testing.T) {
 
 // 4.4.7. Actual Generation Output per Generation Unit [16.1.A]
 func TestActualGenerationOutputPerGenerationUnit(t *testing.T) {
-	c := NewEntsoeClientFromEnv()
+	c := newTestClient(t)
 	psrType := PsrTypeFossilBrownCoalLignite
 	doc, err := c.GetActualGenerationOutputPerGenerationUnit(
 		ProcessTypeRealised,
@@ -480,7 +485,7 @@ func TestActualGenerationOutputPerGenerationUnit(t *testing.T) {
 
 // 4.4.8. Aggregated Generation per Type [16.1.B&C]
 func TestAggregatedGenerationPerType(t *testing.T) {
-	c := NewEntsoeClientFromEnv()
+	c := newTestClient(t)
 	doc, err := c.GetAggregatedGenerationPerType(
 		ProcessTypeRealised,
 		PsrTypeFossilBrownCoalLignite,
@@ -495,7 +500,7 @@ func TestAggregatedGenerationPerType(t *testing.T) {
 // 4.4.9. Aggregated Filling Rate of Water Reservoirs and Hydro Storage Plants [16.1.D]
 func TestAggregatedFillingRateOfWaterReservoirsAndHydroStoragePlants(t *testing.T) {
 	t.Skip("TODO: always returns \"No matching data found for Data item Aggregate Filling Rate of Water Reservoirs and Hydro Storage Plants [16.1.D]\"")
-	c := NewEntsoeClientFromEnv()
+	c := newTestClient(t)
 	doc, err := c.GetAggregatedFillingRateOfWaterReservoirsAndHydroStoragePlants(
 		ProcessTypeRealised,
 		DomainCZ,
@@ -506,6 +511,412 @@ func TestAggregatedFillingRateOfWaterReservoirsAndHydroStoragePlants(t *testing.
 	assert.Nil(t, err)
 }
 
+// 4.5. Master Data
+
+// 4.5.1. Production and Generation Units
+func TestGetProductionAndGenerationUnits(t *testing.T) {
+	c := newTestClient(t)
+	doc, err := c.GetProductionAndGenerationUnits(
+		DomainCZ,
+		genTime("201701010000"),
+		nil,
+	)
+	assert.NotNil(t, doc)
+	assert.Nil(t, err)
+}
+
+func TestLatin1ToUTF8(t *testing.T) {
+	data := []byte("<Configuration_MarketDocument><TimeSeries><registeredResource.location.name>Po\xe8erady</registeredResource.location.name></TimeSeries></Configuration_MarketDocument>")
+	var doc ConfigurationMarketDocument
+	assert.NotNil(t, xml.Unmarshal(data, &doc))
+	assert.Nil(t, xml.Unmarshal(latin1ToUTF8(data), &doc))
+	assert.Equal(t, "Poèerady", doc.TimeSeries[0].RegisteredResourceLocationName)
+
+	valid := []byte("Počerady")
+	assert.Equal(t, valid, latin1ToUTF8(valid))
+}
+
+// 4.6. Balancing domain
+
+// 4.6.1. Current Balancing State [GL EB 12.3.A]
+func TestGetCurrentBalancingState(t *testing.T) {
+	c := newTestClient(t)
+	doc, err := c.GetCurrentBalancingState(
+		DomainCZ,
+		genTime("201912190000"),
+		genTime("201912190010"),
+	)
+	assert.NotNil(t, doc)
+	assert.Nil(t, err)
+}
+
+// 4.6.2. Aggregated Balancing Energy Bids [GL EB 12.3.E]
+func TestGetAggregatedBalancingEnergyBids(t *testing.T) {
+	c := newTestClient(t)
+	doc, err := c.GetAggregatedBalancingEnergyBids(
+		ProcessTypeAutomaticFrequencyRestorationReserve,
+		DomainCZ,
+		genTime("201912161300"),
+		genTime("201912161800"),
+	)
+	assert.NotNil(t, doc)
+	assert.Nil(t, err)
+}
+
+// 4.6.3. Prices of Activated Balancing Energy [GL EB 12.3.F]
+func TestGetBalancingEnergyPrices(t *testing.T) {
+	c := newTestClient(t)
+	doc, err := c.GetBalancingEnergyPrices(
+		ProcessTypeAutomaticFrequencyRestorationReserve,
+		DomainCZ,
+		genTime("201912312300"),
+		genTime("202001010000"),
+	)
+	assert.NotNil(t, doc)
+	assert.Nil(t, err)
+}
+
+// 4.6.4. Use of Allocated Cross-Zonal Balancing Capacity [GL EB 12.3.H&I]
+func TestGetUseOfAllocatedCrossZonalBalancingCapacity(t *testing.T) {
+	c := newTestClient(t)
+	doc, err := c.GetUseOfAllocatedCrossZonalBalancingCapacity(
+		ProcessTypeReplacementReserve,
+		DomainAT,
+		DomainCH,
+		genTime("201912160000"),
+		genTime("201912170000"),
+	)
+	assert.NotNil(t, doc)
+	assert.Nil(t, err)
+}
+
+// 4.6.5. Amount of Balancing Reserves Under Contract [17.1.B]
+func TestGetAmountOfBalancingReservesUnderContract(t *testing.T) {
+	c := newTestClient(t)
+	businessType := BusinessTypeFrequencyContainmentReserve
+	psrType := PsrTypeGeneration
+	doc, err := c.GetAmountOfBalancingReservesUnderContract(
+		ContractMarketAgreementTypeHourly,
+		DomainCZ,
+		genTime("201512312300"),
+		genTime("201601012300"),
+		&businessType,
+		&psrType,
+	)
+	assert.NotNil(t, doc)
+	assert.Nil(t, err)
+}
+
+// 4.6.6. Prices of Procured Balancing Reserves [17.1.C]
+func TestGetPricesOfProcuredBalancingReserves(t *testing.T) {
+	c := newTestClient(t)
+	businessType := BusinessTypeAutomaticFrequencyRestorationReserve
+	doc, err := c.GetPricesOfProcuredBalancingReserves(
+		ContractMarketAgreementTypeDaily,
+		DomainCZ,
+		genTime("201512312300"),
+		genTime("201601012300"),
+		&businessType,
+		nil,
+	)
+	assert.NotNil(t, doc)
+	assert.Nil(t, err)
+}
+
+// 4.6.7. Accepted Aggregated Offers [17.1.D]
+func TestGetAcceptedAggregatedOffers(t *testing.T) {
+	c := newTestClient(t)
+	businessType := BusinessTypeFrequencyContainmentReserve
+	doc, err := c.GetAcceptedAggregatedOffers(
+		DomainCZ,
+		genTime("201512312300"),
+		genTime("201612312300"),
+		&businessType,
+		nil,
+	)
+	assert.NotNil(t, doc)
+	assert.Nil(t, err)
+}
+
+// 4.6.8. Activated Balancing Energy [17.1.E]
+func TestGetActivatedBalancingEnergy(t *testing.T) {
+	c := newTestClient(t)
+	businessType := BusinessTypeAutomaticFrequencyRestorationReserve
+	doc, err := c.GetActivatedBalancingEnergy(
+		DomainCZ,
+		genTime("201512312300"),
+		genTime("201612312300"),
+		&businessType,
+		nil,
+	)
+	assert.NotNil(t, doc)
+	assert.Nil(t, err)
+}
+
+// 4.6.9. Prices of Activated Balancing Energy [17.1.F]
+func TestGetPricesOfActivatedBalancingEnergy(t *testing.T) {
+	c := newTestClient(t)
+	businessType := BusinessTypeAutomaticFrequencyRestorationReserve
+	doc, err := c.GetPricesOfActivatedBalancingEnergy(
+		DomainCZ,
+		genTime("201512312300"),
+		genTime("201612312300"),
+		&businessType,
+		nil,
+	)
+	assert.NotNil(t, doc)
+	assert.Nil(t, err)
+}
+
+// 4.6.10. Imbalance Prices [17.1.G]
+func TestGetImbalancePrices(t *testing.T) {
+	c := newTestClient(t)
+	doc, err := c.GetImbalancePrices(
+		DomainCZ,
+		genTime("201512312300"),
+		genTime("201612312300"),
+	)
+	assert.NotNil(t, doc)
+	assert.Nil(t, err)
+}
+
+// 4.6.11. Total Imbalance Volumes [17.1.H]
+func TestGetTotalImbalanceVolumes(t *testing.T) {
+	c := newTestClient(t)
+	doc, err := c.GetTotalImbalanceVolumes(
+		DomainCZ,
+		genTime("201512312300"),
+		genTime("201612312300"),
+	)
+	assert.NotNil(t, doc)
+	assert.Nil(t, err)
+}
+
+// 4.6.12. Financial Expenses and Income for Balancing [17.1.I]
+func TestGetFinancialExpensesAndIncomeForBalancing(t *testing.T) {
+	c := newTestClient(t)
+	doc, err := c.GetFinancialExpensesAndIncomeForBalancing(
+		DomainCZ,
+		genTime("201512312300"),
+		genTime("201612312300"),
+	)
+	assert.NotNil(t, doc)
+	assert.Nil(t, err)
+}
+
+// 4.6.13. Cross-border Balancing [17.1.J]
+func TestGetCrossBorderBalancing(t *testing.T) {
+	c := newTestClient(t)
+	doc, err := c.GetCrossBorderBalancing(
+		DomainCZ,
+		DomainSK,
+		genTime("201512312300"),
+		genTime("201601010100"),
+	)
+	assert.NotNil(t, doc)
+	assert.Nil(t, err)
+}
+
+// 4.6.14. FCR Total Capacity [SO GL 187.2]
+func TestGetFCRTotalCapacity(t *testing.T) {
+	c := newTestClient(t)
+	doc, err := c.GetFCRTotalCapacity(
+		"10YEU-CONT-SYNC0",
+		genTime("201812312300"),
+		genTime("201912312300"),
+	)
+	assert.NotNil(t, doc)
+	assert.Nil(t, err)
+}
+
+// 4.6.15. Shares of FCR Capacity - Share of Capacity [SO GL 187.2]
+func TestGetShareOfFCRCapacity(t *testing.T) {
+	c := newTestClient(t)
+	doc, err := c.GetShareOfFCRCapacity(
+		DomainDE50Hertz,
+		genTime("201912312300"),
+		genTime("202012312300"),
+	)
+	assert.NotNil(t, doc)
+	assert.Nil(t, err)
+}
+
+// 4.6.16. Shares of FCR Capacity - Contracted Reserve Capacity [SO GL 187.2]
+func TestGetFCRContractedReserveCapacity(t *testing.T) {
+	c := newTestClient(t)
+	doc, err := c.GetFCRContractedReserveCapacity(
+		DomainDEAmprion,
+		genTime("201912312300"),
+		genTime("202012312300"),
+	)
+	assert.NotNil(t, doc)
+	assert.Nil(t, err)
+}
+
+// 4.6.17. FRR Actual Capacity [SO GL 188.4]
+func TestGetFRRActualCapacity(t *testing.T) {
+	c := newTestClient(t)
+	doc, err := c.GetFRRActualCapacity(
+		DomainAT,
+		genTime("201912312300"),
+		genTime("202003312200"),
+	)
+	assert.NotNil(t, doc)
+	assert.Nil(t, err)
+}
+
+// 4.6.18. RR Actual Capacity [SO GL 189.3]
+func TestGetRRActualCapacity(t *testing.T) {
+	c := newTestClient(t)
+	doc, err := c.GetRRActualCapacity(
+		DomainAT,
+		genTime("201912312300"),
+		genTime("202003312200"),
+	)
+	assert.NotNil(t, doc)
+	assert.Nil(t, err)
+}
+
+// 4.6.19. Sharing of RR and FRR [SO GL 190.1]
+func TestGetSharingOfRRAndFRR(t *testing.T) {
+	c := newTestClient(t)
+	doc, err := c.GetSharingOfRRAndFRR(
+		ProcessTypeFrequencyRestorationReserve,
+		"10YCB-GERMANY--8",
+		DomainAT,
+		genTime("201912312300"),
+		genTime("202012312300"),
+	)
+	assert.NotNil(t, doc)
+	assert.Nil(t, err)
+}
+
+// 4.7. Outages domain
+
+// 4.7.1. Unavailability of Consumption Units [7.1A&B]
+func TestGetUnavailabilityOfConsumptionUnits(t *testing.T) {
+	c := newTestClient(t)
+	docs, err := c.GetUnavailabilityOfConsumptionUnits(
+		DomainCZ,
+		genTime("201512312300"),
+		genTime("201612312300"),
+		nil,
+		nil,
+		nil,
+	)
+	assert.NotEmpty(t, docs)
+	assert.Nil(t, err)
+}
+
+// 4.7.2. Unavailability of Transmission Infrastructure [10.1.A&B]
+func TestGetUnavailabilityOfTransmissionInfrastructure(t *testing.T) {
+	c := newTestClient(t)
+	businessType := BusinessTypePlannedMaintenance
+	docs, err := c.GetUnavailabilityOfTransmissionInfrastructure(
+		DomainCZ,
+		DomainSK,
+		genTime("201512312300"),
+		genTime("201612312300"),
+		&businessType,
+		nil,
+		nil,
+	)
+	assert.NotEmpty(t, docs)
+	assert.Nil(t, err)
+}
+
+// 4.7.3. Unavailability of Offshore Grid Infrastructure [10.1.C]
+func TestGetUnavailabilityOfOffshoreGridInfrastructure(t *testing.T) {
+	c := newTestClient(t)
+	docs, err := c.GetUnavailabilityOfOffshoreGridInfrastructure(
+		DomainDETenneT,
+		genTime("201512312300"),
+		genTime("201612312300"),
+		nil,
+		nil,
+	)
+	assert.NotEmpty(t, docs)
+	assert.Nil(t, err)
+}
+
+// 4.7.4. Unavailability of Generation Units [15.1.A&B]
+func TestGetUnavailabilityOfGenerationUnits(t *testing.T) {
+	c := newTestClient(t)
+	businessType := BusinessTypePlannedMaintenance
+	docs, err := c.GetUnavailabilityOfGenerationUnits(
+		DomainCZ,
+		genTime("201512312300"),
+		genTime("201612312300"),
+		&businessType,
+		nil,
+		nil,
+		nil,
+	)
+	assert.NotEmpty(t, docs)
+	assert.Nil(t, err)
+}
+
+// 4.7.5. Unavailability of Production Units [15.1.C&D]
+func TestGetUnavailabilityOfProductionUnits(t *testing.T) {
+	c := newTestClient(t)
+	businessType := BusinessTypePlannedMaintenance
+	docs, err := c.GetUnavailabilityOfProductionUnits(
+		DomainCZ,
+		genTime("201512312300"),
+		genTime("201612312300"),
+		&businessType,
+		nil,
+		nil,
+		pointy.Int(0),
+	)
+	assert.NotEmpty(t, docs)
+	assert.Nil(t, err)
+}
+
+func TestReadZipArchive(t *testing.T) {
+	buf := new(bytes.Buffer)
+	w := zip.NewWriter(buf)
+	for _, name := range []string{"001.xml", "002.xml"} {
+		f, err := w.Create(name)
+		assert.Nil(t, err)
+		_, err = f.Write([]byte("<Unavailability_MarketDocument><mRID>" + name + "</mRID></Unavailability_MarketDocument>"))
+		assert.Nil(t, err)
+	}
+	assert.Nil(t, w.Close())
+
+	assert.True(t, isZipArchive(buf.Bytes()))
+	assert.False(t, isZipArchive([]byte("<?xml version=\"1.0\"?>")))
+
+	files, err := readZipArchive(buf.Bytes())
+	assert.Nil(t, err)
+	assert.Len(t, files, 2)
+	assert.Contains(t, string(files[1]), "002.xml")
+}
+
+func TestRequestWithCancelledContext(t *testing.T) {
+	c := NewEntsoeClient("token")
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	doc, err := c.GetActualTotalLoadCtx(
+		ctx,
+		DomainCZ,
+		genTime("201512312300"),
+		genTime("201612312300"),
+	)
+	assert.Nil(t, doc)
+	assert.True(t, errors.Is(err, context.Canceled))
+}
+
+// newTestClient returns a client for the live API and skips the test if no
+// api key is configured.
+func newTestClient(t *testing.T) *EntsoeClient {
+	c, err := NewEntsoeClientFromEnv()
+	if errors.Is(err, ErrMissingAPIKey) {
+		t.Skip(err)
+	}
+	return c
+}
+
 func genTime(timeString string) time.Time {
 	t, err := time.Parse("200601021504", timeString)
 	if err != nil {
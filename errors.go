@@ -0,0 +1,108 @@
+package goentsoe
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// Sentinel errors for the failure classes callers usually want to tell apart.
+// Match them with errors.Is against the error returned by any request method.
+var (
+	ErrNoMatchingData  = errors.New("entsoe: no matching data found")
+	ErrTooManyRequests = errors.New("entsoe: too many requests")
+	ErrInvalidRequest  = errors.New("entsoe: invalid request")
+	ErrUnauthorized    = errors.New("entsoe: unauthorized")
+)
+
+// ErrMissingAPIKey is returned by NewEntsoeClientFromEnv if ENTSOE_API_KEY is
+// not set.
+var ErrMissingAPIKey = errors.New("entsoe: environment variable ENTSOE_API_KEY with api key not set")
+
+// ErrUnknownArea is returned for domains missing from the area registry where
+// area metadata such as the time zone is needed.
+var ErrUnknownArea = errors.New("entsoe: unknown area")
+
+// APIError is returned when the API answers with an
+// Acknowledgement_MarketDocument or a non-200 status code.
+type APIError struct {
+	// StatusCode is the HTTP status code of the response.
+	StatusCode int
+	// Code is the Reason.Code of the acknowledgement. The API currently uses
+	// 999 for every rejection, so Text is usually more telling.
+	Code string
+	// Text is the Reason.Text of the acknowledgement, or the HTTP status text
+	// if the body was not an acknowledgement.
+	Text string
+	// Acknowledgement is the decoded response body, if there was one.
+	Acknowledgement *AcknowledgementMarketDocument
+}
+
+func (e *APIError) Error() string {
+	if e.Code != "" {
+		return fmt.Sprintf("entsoe: %d %s: %s", e.StatusCode, e.Code, e.Text)
+	}
+	return fmt.Sprintf("entsoe: %d: %s", e.StatusCode, e.Text)
+}
+
+// Is reports whether the error belongs to the class of the sentinel target.
+func (e *APIError) Is(target error) bool {
+	switch target {
+	case ErrNoMatchingData:
+		return strings.Contains(e.Text, "No matching data found")
+	case ErrTooManyRequests:
+		return e.StatusCode == http.StatusTooManyRequests ||
+			strings.Contains(strings.ToLower(e.Text), "max allowed requests") ||
+			strings.Contains(strings.ToLower(e.Text), "too many requests")
+	case ErrUnauthorized:
+		return e.StatusCode == http.StatusUnauthorized || e.StatusCode == http.StatusForbidden
+	case ErrInvalidRequest:
+		return (e.StatusCode == http.StatusBadRequest && !e.Is(ErrNoMatchingData)) ||
+			strings.Contains(e.Text, "is not valid")
+	}
+	return false
+}
+
+// newAPIError builds an APIError from a rejected response. If body is not an
+// acknowledgement document, only the HTTP status is reported.
+func newAPIError(statusCode int, body []byte) *APIError {
+	apiErr := &APIError{
+		StatusCode: statusCode,
+		Text:       http.StatusText(statusCode),
+	}
+	if !isAcknowledgement(body) {
+		return apiErr
+	}
+	var doc AcknowledgementMarketDocument
+	if err := xml.Unmarshal(body, &doc); err != nil {
+		return apiErr
+	}
+	apiErr.Code = doc.Reason.Code
+	apiErr.Text = strings.TrimSpace(doc.Reason.Text)
+	apiErr.Acknowledgement = &doc
+	return apiErr
+}
+
+// isAcknowledgement reports whether the root element of body is an
+// Acknowledgement_MarketDocument.
+func isAcknowledgement(body []byte) bool {
+	return rootElementName(body) == "Acknowledgement_MarketDocument"
+}
+
+// rootElementName returns the local name of the first element in body, or an
+// empty string if body does not start like an XML document.
+func rootElementName(body []byte) string {
+	d := xml.NewDecoder(bytes.NewReader(body))
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return ""
+		}
+		if se, ok := token.(xml.StartElement); ok {
+			return se.Name.Local
+		}
+	}
+}
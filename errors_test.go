@@ -0,0 +1,53 @@
+package goentsoe
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const noMatchingDataAcknowledgement = `<?xml version="1.0" encoding="UTF-8"?>
+<Acknowledgement_MarketDocument xmlns="urn:iec62325.351:tc57wg16:451-1:acknowledgementdocument:7:0">
+	<mRID>71a6d596-0e01-4</mRID>
+	<createdDateTime>2020-09-12T00:13:14Z</createdDateTime>
+	<Reason>
+		<code>999</code>
+		<text>No matching data found for Data item ACTUAL_TOTAL_LOAD_R3 [06.01.A] (10YCZ-CEPS-----N) and interval 2014-12-31T23:00:00.000Z/2015-12-31T23:00:00.000Z.</text>
+	</Reason>
+</Acknowledgement_MarketDocument>`
+
+func TestNewAPIError(t *testing.T) {
+	err := newAPIError(http.StatusOK, []byte(noMatchingDataAcknowledgement))
+	assert.Equal(t, http.StatusOK, err.StatusCode)
+	assert.Equal(t, "999", err.Code)
+	assert.Contains(t, err.Text, "No matching data found")
+	assert.NotNil(t, err.Acknowledgement)
+
+	var wrapped error = fmt.Errorf("fetch load: %w", err)
+	assert.True(t, errors.Is(wrapped, ErrNoMatchingData))
+	assert.False(t, errors.Is(wrapped, ErrTooManyRequests))
+	assert.False(t, errors.Is(wrapped, ErrInvalidRequest))
+
+	var apiErr *APIError
+	assert.True(t, errors.As(wrapped, &apiErr))
+}
+
+func TestNewAPIErrorWithoutAcknowledgement(t *testing.T) {
+	err := newAPIError(http.StatusTooManyRequests, []byte("<html><body>slow down</body></html>"))
+	assert.Equal(t, "", err.Code)
+	assert.Equal(t, "Too Many Requests", err.Text)
+	assert.Nil(t, err.Acknowledgement)
+	assert.True(t, errors.Is(err, ErrTooManyRequests))
+
+	err = newAPIError(http.StatusUnauthorized, nil)
+	assert.True(t, errors.Is(err, ErrUnauthorized))
+}
+
+func TestIsAcknowledgement(t *testing.T) {
+	assert.True(t, isAcknowledgement([]byte(noMatchingDataAcknowledgement)))
+	assert.False(t, isAcknowledgement([]byte(`<?xml version="1.0"?><GL_MarketDocument></GL_MarketDocument>`)))
+	assert.False(t, isAcknowledgement([]byte("PK\x03\x04")))
+}
@@ -0,0 +1,129 @@
+package goentsoe
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// FlowBasedParameters are the flow based parameters of one market time unit:
+// the power transfer distribution factors (PTDF) of the bidding zones on the
+// critical branches and the remaining available margins (RAM) of the
+// branches.
+type FlowBasedParameters struct {
+	Start time.Time
+	End   time.Time
+	// Branches holds the mRID of every critical branch, i.e. of every
+	// constraint, in document order. They are the rows of PTDF.
+	Branches []string
+	// Zones holds the bidding zones in order of first appearance. They are
+	// the columns of PTDF.
+	Zones []DomainType
+	// PTDF[i][j] is the share of the net position of Zones[j] that flows
+	// over Branches[i]. Zones a constraint does not list have a PTDF of 0.
+	PTDF [][]float64
+	// RAM[i] is the remaining available margin of Branches[i] in MW.
+	RAM []float64
+}
+
+// ConvertCriticalNetworkElementMarketDocument returns the flow based
+// parameters of every market time unit of doc, as returned by
+// GetFlowBasedParameters.
+func ConvertCriticalNetworkElementMarketDocument(doc *CriticalNetworkElementMarketDocument) ([]FlowBasedParameters, error) {
+	var res []FlowBasedParameters
+	for _, ts := range doc.TimeSeries {
+		start, err := time.Parse("2006-01-02T15:04Z", ts.Period.TimeInterval.Start)
+		if err != nil {
+			return nil, fmt.Errorf("time series %s: %w", ts.MRID, err)
+		}
+		resolution, err := parseResolution(ts.Period.Resolution)
+		if err != nil {
+			return nil, fmt.Errorf("time series %s: %w", ts.MRID, err)
+		}
+		start = start.In(periodLocation(DomainType(doc.DomainMRID)))
+		for _, point := range ts.Period.Point {
+			position, err := strconv.Atoi(point.Position)
+			if err != nil || position < 1 {
+				return nil, fmt.Errorf("time series %s: invalid position %q", ts.MRID, point.Position)
+			}
+			p := FlowBasedParameters{
+				Start: resolution.AddTo(start, position-1).UTC(),
+				End:   resolution.AddTo(start, position).UTC(),
+			}
+			zones := make(map[DomainType]int)
+			for _, constraint := range point.ConstraintTimeSeries {
+				resource := constraint.MonitoredRegisteredResource
+				ram, err := strconv.ParseFloat(resource.FlowBasedStudyDomainFlowBasedMarginQuantityQuantity, 64)
+				if err != nil {
+					return nil, fmt.Errorf("time series %s: position %d: constraint %s: invalid margin: %w", ts.MRID, position, constraint.MRID, err)
+				}
+				row := make([]float64, len(p.Zones), len(p.Zones)+len(resource.PTDFDomain))
+				for _, domain := range resource.PTDFDomain {
+					ptdf, err := strconv.ParseFloat(domain.PTDFQuantityQuantity, 64)
+					if err != nil {
+						return nil, fmt.Errorf("time series %s: position %d: constraint %s: invalid PTDF of %s: %w", ts.MRID, position, constraint.MRID, domain.MRID, err)
+					}
+					zone := DomainType(domain.MRID)
+					j, ok := zones[zone]
+					if !ok {
+						j = len(p.Zones)
+						zones[zone] = j
+						p.Zones = append(p.Zones, zone)
+						row = append(row, 0)
+					}
+					row[j] = ptdf
+				}
+				p.Branches = append(p.Branches, constraint.MRID)
+				p.PTDF = append(p.PTDF, row)
+				p.RAM = append(p.RAM, ram)
+			}
+			// Rows of earlier constraints lack the zones seen later on.
+			for i, row := range p.PTDF {
+				for len(row) < len(p.Zones) {
+					row = append(row, 0)
+				}
+				p.PTDF[i] = row
+			}
+			res = append(res, p)
+		}
+	}
+	return res, nil
+}
+
+// Flows returns the flow on every branch resulting from the net positions of
+// the bidding zones in MW. Zones without a net position are taken as
+// balanced.
+func (p *FlowBasedParameters) Flows(netPositions map[DomainType]float64) ([]float64, error) {
+	known := make(map[DomainType]bool, len(p.Zones))
+	for _, zone := range p.Zones {
+		known[zone] = true
+	}
+	for zone := range netPositions {
+		if !known[zone] {
+			return nil, fmt.Errorf("bidding zone %s has no PTDF", zone)
+		}
+	}
+
+	flows := make([]float64, len(p.Branches))
+	for i, row := range p.PTDF {
+		for j, zone := range p.Zones {
+			flows[i] += row[j] * netPositions[zone]
+		}
+	}
+	return flows, nil
+}
+
+// Margins returns the margin left on every branch with the given net
+// positions, i.e. the RAM minus the flow. A negative margin marks an
+// overloaded branch.
+func (p *FlowBasedParameters) Margins(netPositions map[DomainType]float64) ([]float64, error) {
+	flows, err := p.Flows(netPositions)
+	if err != nil {
+		return nil, err
+	}
+	margins := make([]float64, len(flows))
+	for i, flow := range flows {
+		margins[i] = p.RAM[i] - flow
+	}
+	return margins, nil
+}
@@ -0,0 +1,89 @@
+package goentsoe
+
+import (
+	"encoding/xml"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const flowBasedParametersDocument = `<?xml version="1.0" encoding="UTF-8"?>
+<CriticalNetworkElement_MarketDocument xmlns="urn:iec62325.351:tc57wg16:451-n:cnedocument:2:0">
+	<mRID>38e3d7b3f58d4fca84249c230</mRID>
+	<type>B11</type>
+	<TimeSeries>
+		<mRID>1</mRID>
+		<businessType>B39</businessType>
+		<curveType>A01</curveType>
+		<Period>
+			<timeInterval>
+				<start>2015-12-31T23:00Z</start>
+				<end>2016-01-01T23:00Z</end>
+			</timeInterval>
+			<resolution>PT60M</resolution>
+			<Point>
+				<position>2</position>
+				<Constraint_TimeSeries>
+					<mRID>14648370000</mRID>
+					<businessType>B09</businessType>
+					<Monitored_RegisteredResource>
+						<flowBasedStudy_Domain.mRID>10YDOM-REGION-1V</flowBasedStudy_Domain.mRID>
+						<flowBasedStudy_Domain.flowBasedMargin_Quantity.quantity>756</flowBasedStudy_Domain.flowBasedMargin_Quantity.quantity>
+						<PTDF_Domain><mRID>10YBE----------2</mRID><pTDF_Quantity.quantity>0.1</pTDF_Quantity.quantity></PTDF_Domain>
+						<PTDF_Domain><mRID>10YNL----------L</mRID><pTDF_Quantity.quantity>-0.2</pTDF_Quantity.quantity></PTDF_Domain>
+					</Monitored_RegisteredResource>
+				</Constraint_TimeSeries>
+				<Constraint_TimeSeries>
+					<mRID>12144770000</mRID>
+					<businessType>B09</businessType>
+					<Monitored_RegisteredResource>
+						<flowBasedStudy_Domain.mRID>10YDOM-REGION-1V</flowBasedStudy_Domain.mRID>
+						<flowBasedStudy_Domain.flowBasedMargin_Quantity.quantity>100</flowBasedStudy_Domain.flowBasedMargin_Quantity.quantity>
+						<PTDF_Domain><mRID>10YNL----------L</mRID><pTDF_Quantity.quantity>0.05</pTDF_Quantity.quantity></PTDF_Domain>
+						<PTDF_Domain><mRID>10YFR-RTE------C</mRID><pTDF_Quantity.quantity>0.3</pTDF_Quantity.quantity></PTDF_Domain>
+					</Monitored_RegisteredResource>
+				</Constraint_TimeSeries>
+			</Point>
+		</Period>
+	</TimeSeries>
+</CriticalNetworkElement_MarketDocument>`
+
+func TestConvertCriticalNetworkElementMarketDocument(t *testing.T) {
+	var doc CriticalNetworkElementMarketDocument
+	assert.Nil(t, xml.Unmarshal([]byte(flowBasedParametersDocument), &doc))
+
+	params, err := ConvertCriticalNetworkElementMarketDocument(&doc)
+	assert.Nil(t, err)
+	assert.Len(t, params, 1)
+	p := params[0]
+	assert.Equal(t, time.Date(2016, 1, 1, 0, 0, 0, 0, time.UTC), p.Start)
+	assert.Equal(t, time.Date(2016, 1, 1, 1, 0, 0, 0, time.UTC), p.End)
+	assert.Equal(t, []string{"14648370000", "12144770000"}, p.Branches)
+	assert.Equal(t, []DomainType{DomainBE, DomainNL, DomainFR}, p.Zones)
+	assert.Equal(t, [][]float64{
+		{0.1, -0.2, 0},
+		{0, 0.05, 0.3},
+	}, p.PTDF)
+	assert.Equal(t, []float64{756, 100}, p.RAM)
+
+	netPositions := map[DomainType]float64{DomainBE: 1000, DomainFR: 500}
+	flows, err := p.Flows(netPositions)
+	assert.Nil(t, err)
+	assert.InDeltaSlice(t, []float64{100, 150}, flows, 1e-9)
+	margins, err := p.Margins(netPositions)
+	assert.Nil(t, err)
+	assert.InDeltaSlice(t, []float64{656, -50}, margins, 1e-9)
+
+	_, err = p.Flows(map[DomainType]float64{DomainDE: 1})
+	assert.EqualError(t, err, "bidding zone 10Y1001A1001A83F has no PTDF")
+}
+
+func TestConvertCriticalNetworkElementMarketDocumentRejectsMalformedValues(t *testing.T) {
+	var doc CriticalNetworkElementMarketDocument
+	assert.Nil(t, xml.Unmarshal([]byte(flowBasedParametersDocument), &doc))
+	doc.TimeSeries[0].Period.Point[0].ConstraintTimeSeries[1].MonitoredRegisteredResource.PTDFDomain[0].PTDFQuantityQuantity = "n/a"
+
+	_, err := ConvertCriticalNetworkElementMarketDocument(&doc)
+	assert.EqualError(t, err, `time series 1: position 2: constraint 12144770000: invalid PTDF of 10YNL----------L: strconv.ParseFloat: parsing "n/a": invalid syntax`)
+}
@@ -0,0 +1,125 @@
+package goentsoe
+
+import (
+	"context"
+	"net/url"
+	"sort"
+	"time"
+)
+
+// GenerationMix is the actual generation of a zone per production type as a
+// wide table: one row per point in time and one column per production type.
+type GenerationMix struct {
+	Zone DomainType
+	// Unit is the measure unit of the values, usually MAW.
+	Unit string
+	// Resolution is the length of every row. Production types published in
+	// a finer resolution are averaged to the coarsest resolution of all
+	// series.
+	Resolution time.Duration
+	// Times holds the start of every row in ascending order.
+	Times []time.Time
+	// Generation holds a column per production type. Generation[psrType][i]
+	// is the generation of psrType over the row starting at Times[i], NaN if
+	// the type has no data for the whole row.
+	Generation map[PsrType][]float64
+	// Consumption holds the consumption of production types that also
+	// consume, such as pumped storage while pumping, in the same layout as
+	// Generation.
+	Consumption map[PsrType][]float64
+}
+
+// GenerationMix returns the actual generation per production type of zone,
+// fetched with a single request for all production types.
+func (c *EntsoeClient) GenerationMix(ctx context.Context, zone DomainType, periodStart, periodEnd time.Time) (*GenerationMix, error) {
+	series, err := c.generationPerType(ctx, zone, periodStart, periodEnd)
+	if err != nil {
+		return nil, err
+	}
+	mix := NewGenerationMix(series)
+	mix.Zone = zone
+	return mix, nil
+}
+
+// generationPerType fetches the actual generation of all production types of
+// zone.
+func (c *EntsoeClient) generationPerType(ctx context.Context, zone DomainType, periodStart, periodEnd time.Time) ([]TimeSeries, error) {
+	params := url.Values{}
+	params.Add(ParameterDocumentType, string(DocumentTypeActualGenerationPerType))
+	params.Add(ParameterProcessType, string(ProcessTypeRealised))
+	params.Add(ParameterInDomain, string(zone))
+	params.Add(ParameterPeriodStart, periodStart.UTC().Format("200601021504"))
+	params.Add(ParameterPeriodEnd, periodEnd.UTC().Format("200601021504"))
+	doc, err := c.requestGLMarketDocument(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	return ConvertGLMarketDocument(doc)
+}
+
+// NewGenerationMix arranges generation per type series in a table. Series
+// with an out but no in bidding zone are consumption, all others generation.
+func NewGenerationMix(series []TimeSeries) *GenerationMix {
+	mix := &GenerationMix{
+		Resolution:  coarsestResolution(series),
+		Generation:  make(map[PsrType][]float64),
+		Consumption: make(map[PsrType][]float64),
+	}
+
+	generation := make(map[PsrType][]TimeSeries)
+	consumption := make(map[PsrType][]TimeSeries)
+	for _, ts := range series {
+		if mix.Unit == "" {
+			mix.Unit = ts.Unit
+		}
+		if ts.InDomain == "" && ts.OutDomain != "" {
+			consumption[ts.PsrType] = append(consumption[ts.PsrType], ts)
+		} else {
+			generation[ts.PsrType] = append(generation[ts.PsrType], ts)
+		}
+	}
+
+	loc := seriesLocation(series)
+	var columns []map[time.Time]float64
+	resampled := func(byType map[PsrType][]TimeSeries) map[PsrType]map[time.Time]float64 {
+		res := make(map[PsrType]map[time.Time]float64, len(byType))
+		for psrType, s := range byType {
+			res[psrType] = resample(s, mix.Resolution, loc)
+			columns = append(columns, res[psrType])
+		}
+		return res
+	}
+	generationColumns, consumptionColumns := resampled(generation), resampled(consumption)
+	mix.Times = intervalStarts(columns...)
+	for psrType, column := range generationColumns {
+		mix.Generation[psrType] = alignColumn(mix.Times, column)
+	}
+	for psrType, column := range consumptionColumns {
+		mix.Consumption[psrType] = alignColumn(mix.Times, column)
+	}
+	return mix
+}
+
+// PsrTypes returns the production types with generation in ascending order.
+func (m *GenerationMix) PsrTypes() []PsrType {
+	res := make([]PsrType, 0, len(m.Generation))
+	for psrType := range m.Generation {
+		res = append(res, psrType)
+	}
+	sort.Slice(res, func(i, j int) bool {
+		return res[i] < res[j]
+	})
+	return res
+}
+
+// Total returns the generation of all production types per row. Rows for
+// which a production type has no data are NaN rather than a partial sum.
+func (m *GenerationMix) Total() []float64 {
+	res := make([]float64, len(m.Times))
+	for _, column := range m.Generation {
+		for i, v := range column {
+			res[i] += v
+		}
+	}
+	return res
+}
@@ -0,0 +1,128 @@
+package goentsoe
+
+import (
+	"context"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const generationPerTypeDocument = `<?xml version="1.0" encoding="UTF-8"?>
+<GL_MarketDocument xmlns="urn:iec62325.351:tc57wg16:451-6:generationloaddocument:3:0">
+	<mRID>1b4ce3ad4cd84b1b8fd6ea4c2</mRID>
+	<type>A75</type>
+	<TimeSeries>
+		<mRID>1</mRID>
+		<businessType>A01</businessType>
+		<inBiddingZone_Domain.mRID codingScheme="A01">10YAT-APG------L</inBiddingZone_Domain.mRID>
+		<quantity_Measure_Unit.name>MAW</quantity_Measure_Unit.name>
+		<curveType>A01</curveType>
+		<MktPSRType><psrType>B16</psrType></MktPSRType>
+		<Period>
+			<timeInterval><start>2023-06-01T10:00Z</start><end>2023-06-01T11:00Z</end></timeInterval>
+			<resolution>PT15M</resolution>
+			<Point><position>1</position><quantity>100</quantity></Point>
+			<Point><position>2</position><quantity>110</quantity></Point>
+			<Point><position>3</position><quantity>120</quantity></Point>
+			<Point><position>4</position><quantity>130</quantity></Point>
+		</Period>
+	</TimeSeries>
+	<TimeSeries>
+		<mRID>2</mRID>
+		<businessType>A01</businessType>
+		<inBiddingZone_Domain.mRID codingScheme="A01">10YAT-APG------L</inBiddingZone_Domain.mRID>
+		<quantity_Measure_Unit.name>MAW</quantity_Measure_Unit.name>
+		<curveType>A01</curveType>
+		<MktPSRType><psrType>B10</psrType></MktPSRType>
+		<Period>
+			<timeInterval><start>2023-06-01T10:00Z</start><end>2023-06-01T11:00Z</end></timeInterval>
+			<resolution>PT60M</resolution>
+			<Point><position>1</position><quantity>50</quantity></Point>
+		</Period>
+	</TimeSeries>
+	<TimeSeries>
+		<mRID>3</mRID>
+		<businessType>A01</businessType>
+		<outBiddingZone_Domain.mRID codingScheme="A01">10YAT-APG------L</outBiddingZone_Domain.mRID>
+		<quantity_Measure_Unit.name>MAW</quantity_Measure_Unit.name>
+		<curveType>A01</curveType>
+		<MktPSRType><psrType>B10</psrType></MktPSRType>
+		<Period>
+			<timeInterval><start>2023-06-01T10:00Z</start><end>2023-06-01T11:00Z</end></timeInterval>
+			<resolution>PT60M</resolution>
+			<Point><position>1</position><quantity>300</quantity></Point>
+		</Period>
+	</TimeSeries>
+</GL_MarketDocument>`
+
+func TestGenerationMix(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, string(DocumentTypeActualGenerationPerType), r.URL.Query().Get(ParameterDocumentType))
+		assert.Equal(t, string(DomainAT), r.URL.Query().Get(ParameterInDomain))
+		_, ok := r.URL.Query()[ParameterPsrType]
+		assert.False(t, ok)
+		w.Write([]byte(generationPerTypeDocument))
+	}))
+	defer server.Close()
+
+	c := NewEntsoeClient("token", WithBaseURL(server.URL))
+	mix, err := c.GenerationMix(
+		context.Background(),
+		DomainAT,
+		time.Date(2023, 6, 1, 10, 0, 0, 0, time.UTC),
+		time.Date(2023, 6, 1, 11, 0, 0, 0, time.UTC),
+	)
+	assert.Nil(t, err)
+	assert.Equal(t, DomainAT, mix.Zone)
+	assert.Equal(t, "MAW", mix.Unit)
+	// The quarter-hourly solar generation is averaged to the hourly
+	// resolution of pumped storage.
+	assert.Equal(t, time.Hour, mix.Resolution)
+	assert.Equal(t, []time.Time{time.Date(2023, 6, 1, 10, 0, 0, 0, time.UTC)}, mix.Times)
+	assert.Equal(t, []PsrType{PsrTypeHydroPumpedStorage, PsrTypeSolar}, mix.PsrTypes())
+	assert.Equal(t, []float64{115}, mix.Generation[PsrTypeSolar])
+	assert.Equal(t, []float64{50}, mix.Generation[PsrTypeHydroPumpedStorage])
+	assert.Len(t, mix.Consumption, 1)
+	assert.Equal(t, []float64{300}, mix.Consumption[PsrTypeHydroPumpedStorage])
+
+	assert.Equal(t, []float64{165}, mix.Total())
+}
+
+func TestNewGenerationMixMarksPartialRowsMissing(t *testing.T) {
+	start := time.Date(2023, 6, 1, 10, 0, 0, 0, time.UTC)
+	nan := math.NaN()
+	mix := NewGenerationMix([]TimeSeries{
+		testSeries("1", PsrTypeSolar, start, 15*time.Minute, 100, 100, 100, 100, 200, nan, 200, 200),
+		testSeries("2", PsrTypeBiomass, start, time.Hour, 50, 50),
+		testSeries("3", PsrTypeWindOnshore, start.Add(time.Hour), time.Hour, 10),
+	})
+	assert.Equal(t, []time.Time{start, start.Add(time.Hour)}, mix.Times)
+	assert.Equal(t, 100.0, mix.Generation[PsrTypeSolar][0])
+	assert.True(t, math.IsNaN(mix.Generation[PsrTypeSolar][1]))
+	assert.True(t, math.IsNaN(mix.Generation[PsrTypeWindOnshore][0]))
+
+	total := mix.Total()
+	assert.True(t, math.IsNaN(total[0]))
+	assert.True(t, math.IsNaN(total[1]))
+}
+
+func TestNewGenerationMixAlignsToLocalDays(t *testing.T) {
+	// The delivery day of 1 June 2023 in Germany starts at 22:00 UTC.
+	start := time.Date(2023, 5, 31, 22, 0, 0, 0, time.UTC)
+	hourly := make([]float64, 24)
+	for i := range hourly {
+		hourly[i] = 10
+	}
+	solar := testSeries("1", PsrTypeSolar, start, time.Hour, hourly...)
+	biomass := testSeries("2", PsrTypeBiomass, start, 24*time.Hour, 100)
+	solar.InDomain, biomass.InDomain = DomainDELU, DomainDELU
+	mix := NewGenerationMix([]TimeSeries{solar, biomass})
+	assert.Equal(t, 24*time.Hour, mix.Resolution)
+	assert.Equal(t, []time.Time{start}, mix.Times)
+	assert.Equal(t, []float64{10}, mix.Generation[PsrTypeSolar])
+	assert.Equal(t, []float64{100}, mix.Generation[PsrTypeBiomass])
+}
@@ -0,0 +1,91 @@
+package goentsoe
+
+import (
+	"fmt"
+
+	"github.com/sirupsen/logrus"
+)
+
+// Logger receives the diagnostic events of the client. Each event is a
+// message followed by alternating keys and values. *slog.Logger satisfies
+// Logger, logrus loggers can be adapted with NewLogrusLogger.
+type Logger interface {
+	Debug(msg string, keyvals ...interface{})
+	Info(msg string, keyvals ...interface{})
+	Warn(msg string, keyvals ...interface{})
+	Error(msg string, keyvals ...interface{})
+}
+
+// WithLogger makes the client report its events to logger. By default
+// nothing is logged.
+func WithLogger(logger Logger) Option {
+	return func(c *EntsoeClient) {
+		if logger == nil {
+			logger = nopLogger{}
+		}
+		c.logger = logger
+	}
+}
+
+// maxLoggedBodySize limits how much of a response body is included in log
+// events.
+const maxLoggedBodySize = 512
+
+// logDecodeError reports a response body that could not be unmarshalled.
+// Only the beginning of the body is logged, at debug level.
+func (c *EntsoeClient) logDecodeError(body []byte, err error) {
+	c.logger.Error("entsoe: decoding response failed", "root", rootElementName(body), "size", len(body), "err", err)
+	if len(body) > maxLoggedBodySize {
+		body = body[:maxLoggedBodySize]
+	}
+	c.logger.Debug("entsoe: undecodable response", "body", string(body))
+}
+
+type nopLogger struct{}
+
+func (nopLogger) Debug(string, ...interface{}) {}
+func (nopLogger) Info(string, ...interface{})  {}
+func (nopLogger) Warn(string, ...interface{})  {}
+func (nopLogger) Error(string, ...interface{}) {}
+
+// NewLogrusLogger adapts a logrus logger or entry to Logger. The keys and
+// values of an event become logrus fields.
+func NewLogrusLogger(logger logrus.FieldLogger) Logger {
+	return logrusLogger{logger}
+}
+
+type logrusLogger struct {
+	logger logrus.FieldLogger
+}
+
+func (l logrusLogger) Debug(msg string, keyvals ...interface{}) {
+	l.withFields(keyvals).Debug(msg)
+}
+
+func (l logrusLogger) Info(msg string, keyvals ...interface{}) {
+	l.withFields(keyvals).Info(msg)
+}
+
+func (l logrusLogger) Warn(msg string, keyvals ...interface{}) {
+	l.withFields(keyvals).Warn(msg)
+}
+
+func (l logrusLogger) Error(msg string, keyvals ...interface{}) {
+	l.withFields(keyvals).Error(msg)
+}
+
+func (l logrusLogger) withFields(keyvals []interface{}) logrus.FieldLogger {
+	if len(keyvals) == 0 {
+		return l.logger
+	}
+	fields := make(logrus.Fields, (len(keyvals)+1)/2)
+	for i := 0; i < len(keyvals); i += 2 {
+		key := fmt.Sprint(keyvals[i])
+		if i+1 < len(keyvals) {
+			fields[key] = keyvals[i+1]
+		} else {
+			fields[key] = nil
+		}
+	}
+	return l.logger.WithFields(fields)
+}
@@ -0,0 +1,86 @@
+package goentsoe
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	logrustest "github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+)
+
+type recordingLogger struct {
+	mu     sync.Mutex
+	events []string
+}
+
+func (l *recordingLogger) record(level, msg string, keyvals []interface{}) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.events = append(l.events, fmt.Sprint(level, " ", msg, " ", keyvals))
+}
+
+func (l *recordingLogger) Debug(msg string, keyvals ...interface{}) { l.record("DEBUG", msg, keyvals) }
+func (l *recordingLogger) Info(msg string, keyvals ...interface{})  { l.record("INFO", msg, keyvals) }
+func (l *recordingLogger) Warn(msg string, keyvals ...interface{})  { l.record("WARN", msg, keyvals) }
+func (l *recordingLogger) Error(msg string, keyvals ...interface{}) { l.record("ERROR", msg, keyvals) }
+
+func TestLoggerRedactsTokenAndTruncatesBody(t *testing.T) {
+	body := "<GL_MarketDocument>" + strings.Repeat("x", 2*maxLoggedBodySize)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(body))
+	}))
+	defer server.Close()
+
+	logger := &recordingLogger{}
+	c := NewEntsoeClient("secret-token", WithBaseURL(server.URL), WithLogger(logger))
+	_, err := c.GetActualTotalLoad(
+		DomainCZ,
+		genTime("201512312300"),
+		genTime("201601012300"),
+	)
+	assert.NotNil(t, err)
+
+	assert.Len(t, logger.events, 3)
+	assert.Contains(t, logger.events[0], "DEBUG entsoe: request done")
+	assert.Contains(t, logger.events[0], "securityToken=REDACTED")
+	assert.Contains(t, logger.events[0], fmt.Sprint("size ", len(body)))
+	assert.Contains(t, logger.events[1], "ERROR entsoe: decoding response failed [root GL_MarketDocument")
+	assert.Contains(t, logger.events[2], "DEBUG entsoe: undecodable response")
+	for _, event := range logger.events {
+		assert.NotContains(t, event, "secret-token")
+		assert.Less(t, len(event), 2*maxLoggedBodySize)
+	}
+}
+
+func TestRequestErrorsRedactToken(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	server.Close()
+
+	c := NewEntsoeClient("secret-token", WithBaseURL(server.URL), WithRetryPolicy(RetryPolicy{}))
+	_, err := c.GetActualTotalLoad(
+		DomainCZ,
+		genTime("201512312300"),
+		genTime("201612312300"),
+	)
+	assert.NotNil(t, err)
+	assert.NotContains(t, err.Error(), "secret-token")
+}
+
+func TestLogrusLogger(t *testing.T) {
+	logger, hook := logrustest.NewNullLogger()
+	logger.SetLevel(logrus.DebugLevel)
+
+	l := NewLogrusLogger(logger)
+	l.Debug("request", "status", 200, "size")
+	l.Warn("retry")
+
+	assert.Len(t, hook.Entries, 2)
+	assert.Equal(t, logrus.DebugLevel, hook.Entries[0].Level)
+	assert.Equal(t, logrus.Fields{"status": 200, "size": nil}, hook.Entries[0].Data)
+	assert.Equal(t, "retry", hook.LastEntry().Message)
+}
@@ -0,0 +1,44 @@
+package goentsoe
+
+import (
+	"net/http"
+	"strings"
+	"time"
+)
+
+// Option configures an EntsoeClient. Pass options to NewEntsoeClient or
+// NewEntsoeClientFromEnv.
+type Option func(*EntsoeClient)
+
+// WithHTTPClient makes the client send its requests through httpClient, e.g.
+// one configured with a corporate proxy or custom TLS settings.
+func WithHTTPClient(httpClient *http.Client) Option {
+	return func(c *EntsoeClient) {
+		if httpClient != nil {
+			c.httpClient = httpClient
+		}
+	}
+}
+
+// WithBaseURL replaces DefaultBaseURL, e.g. with
+// "https://web-api.tp.entsoe.eu/api" or the URL of a local test server.
+func WithBaseURL(baseURL string) Option {
+	return func(c *EntsoeClient) {
+		c.baseURL = strings.TrimSuffix(baseURL, "?")
+	}
+}
+
+// WithUserAgent sets the User-Agent header sent with every request.
+func WithUserAgent(userAgent string) Option {
+	return func(c *EntsoeClient) {
+		c.userAgent = userAgent
+	}
+}
+
+// WithTimeout limits the duration of a single request, including reading the
+// response body. It applies on top of any deadline set on the context.
+func WithTimeout(timeout time.Duration) Option {
+	return func(c *EntsoeClient) {
+		c.timeout = timeout
+	}
+}
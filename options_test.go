@@ -0,0 +1,61 @@
+package goentsoe
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const emptyGLMarketDocument = `<?xml version="1.0" encoding="UTF-8"?>
+<GL_MarketDocument xmlns="urn:iec62325.351:tc57wg16:451-6:generationloaddocument:3:0">
+	<mRID>ed7acd8a6d784b7ab2a703950</mRID>
+	<type>A65</type>
+</GL_MarketDocument>`
+
+func TestClientOptions(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api", r.URL.Path)
+		assert.Equal(t, "token", r.URL.Query().Get("securityToken"))
+		assert.Equal(t, string(DocumentTypeSystemTotalLoad), r.URL.Query().Get(ParameterDocumentType))
+		assert.Equal(t, "go-entsoe-test", r.UserAgent())
+		w.Write([]byte(emptyGLMarketDocument))
+	}))
+	defer server.Close()
+
+	c := NewEntsoeClient(
+		"token",
+		WithBaseURL(server.URL+"/api"),
+		WithHTTPClient(server.Client()),
+		WithUserAgent("go-entsoe-test"),
+	)
+	doc, err := c.GetActualTotalLoad(
+		DomainCZ,
+		genTime("201512312300"),
+		genTime("201612312300"),
+	)
+	assert.Nil(t, err)
+	assert.Equal(t, "ed7acd8a6d784b7ab2a703950", doc.MRID)
+}
+
+func TestClientTimeout(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-time.After(time.Second):
+		}
+	}))
+	defer server.Close()
+
+	c := NewEntsoeClient("token", WithBaseURL(server.URL), WithTimeout(10*time.Millisecond), WithRetryPolicy(RetryPolicy{}))
+	_, err := c.GetActualTotalLoad(
+		DomainCZ,
+		genTime("201512312300"),
+		genTime("201612312300"),
+	)
+	assert.True(t, errors.Is(err, context.DeadlineExceeded))
+}
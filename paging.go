@@ -0,0 +1,130 @@
+package goentsoe
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// OutageQuery selects the outage documents returned by Outages. Fields left
+// at their zero value are not sent.
+type OutageQuery struct {
+	// DocumentType is one of the unavailability document types A76 to A80.
+	DocumentType       DocumentType
+	BiddingZoneDomain  DomainType
+	InDomain           DomainType
+	OutDomain          DomainType
+	PeriodStart        time.Time
+	PeriodEnd          time.Time
+	BusinessType       *BusinessType
+	DocStatus          *DocStatus
+	RegisteredResource *string
+}
+
+func (q *OutageQuery) params() url.Values {
+	params := url.Values{}
+	params.Add(ParameterDocumentType, string(q.DocumentType))
+	if q.BiddingZoneDomain != "" {
+		params.Add(ParameterBiddingZoneDomain, string(q.BiddingZoneDomain))
+	}
+	if q.InDomain != "" {
+		params.Add(ParameterInDomain, string(q.InDomain))
+	}
+	if q.OutDomain != "" {
+		params.Add(ParameterOutDomain, string(q.OutDomain))
+	}
+	params.Add(ParameterPeriodStart, q.PeriodStart.UTC().Format("200601021504"))
+	params.Add(ParameterPeriodEnd, q.PeriodEnd.UTC().Format("200601021504"))
+	if q.BusinessType != nil {
+		params.Add(ParameterBusinessType, string(*q.BusinessType))
+	}
+	if q.DocStatus != nil {
+		params.Add(ParameterDocStatus, string(*q.DocStatus))
+	}
+	if q.RegisteredResource != nil {
+		params.Add(ParameterRegisteredResource, *q.RegisteredResource)
+	}
+	return params
+}
+
+// OutageIterator walks all documents matching an OutageQuery, requesting
+// further pages with an increasing offset while pages are full. Of the
+// balancing queries only the balancing energy bids page by offset, and the
+// client has no endpoint for those, so outages are the only documents paged.
+//
+//	it := c.Outages(ctx, query)
+//	for it.Next() {
+//		doc := it.Document()
+//		...
+//	}
+//	if err := it.Err(); err != nil {
+//		...
+//	}
+type OutageIterator struct {
+	c       *EntsoeClient
+	ctx     context.Context
+	windows []url.Values
+	offset  int
+	page    []UnavailabilityMarketDocument
+	doc     *UnavailabilityMarketDocument
+	err     error
+}
+
+// Outages returns an iterator over all outage documents matching query.
+// Nothing is requested before the first call to Next.
+func (c *EntsoeClient) Outages(ctx context.Context, query OutageQuery) *OutageIterator {
+	return &OutageIterator{
+		c:       c,
+		ctx:     ctx,
+		windows: c.splitPeriod(query.params()),
+	}
+}
+
+// Next advances to the next document and reports whether there is one. It
+// returns false at the end of the results or on error; check Err afterwards.
+func (it *OutageIterator) Next() bool {
+	it.doc = nil
+	for len(it.page) == 0 {
+		if it.err != nil || len(it.windows) == 0 {
+			return false
+		}
+		params := url.Values{}
+		for k, v := range it.windows[0] {
+			params[k] = v
+		}
+		params.Set(ParameterOffset, strconv.Itoa(it.offset))
+
+		page, err := it.c.fetchUnavailabilityMarketDocuments(it.ctx, params)
+		if errors.Is(err, ErrNoMatchingData) {
+			page, err = nil, nil
+		}
+		if err != nil {
+			it.err = err
+			return false
+		}
+		if len(page) < maxDocumentsPerPage {
+			// A page that is not full is the last one of the window.
+			it.windows = it.windows[1:]
+			it.offset = 0
+		} else {
+			it.offset += len(page)
+		}
+		it.page = page
+	}
+	it.doc = &it.page[0]
+	it.page = it.page[1:]
+	return true
+}
+
+// Document returns the current document. It is only valid after Next
+// returned true.
+func (it *OutageIterator) Document() *UnavailabilityMarketDocument {
+	return it.doc
+}
+
+// Err returns the first error that stopped the iteration, if any.
+func (it *OutageIterator) Err() error {
+	return it.err
+}
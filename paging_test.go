@@ -0,0 +1,137 @@
+package goentsoe
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOutageIterator(t *testing.T) {
+	server, requests := newOutageServer(250)
+	defer server.Close()
+
+	c := NewEntsoeClient("token", WithBaseURL(server.URL))
+	it := c.Outages(context.Background(), OutageQuery{
+		DocumentType:      DocumentTypeGenerationUnavailability,
+		BiddingZoneDomain: DomainCZ,
+		PeriodStart:       genTime("201512312300"),
+		PeriodEnd:         genTime("201612312300"),
+	})
+	var mRIDs []string
+	for it.Next() {
+		mRIDs = append(mRIDs, it.Document().MRID)
+	}
+	assert.Nil(t, it.Err())
+	assert.Len(t, mRIDs, 250)
+	assert.Equal(t, "201512312300/0", mRIDs[0])
+	assert.Equal(t, "201512312300/249", mRIDs[249])
+	// The third page is not full, so no further page is requested.
+	assert.Equal(t, []outageRequest{
+		{"201512312300", "0"}, {"201512312300", "100"}, {"201512312300", "200"},
+	}, requests())
+}
+
+func TestOutageIteratorSkipsWindowsWithoutData(t *testing.T) {
+	server, requests := newOutageServer(0)
+	defer server.Close()
+
+	c := NewEntsoeClient("token", WithBaseURL(server.URL))
+	it := c.Outages(context.Background(), OutageQuery{
+		DocumentType:      DocumentTypeGenerationUnavailability,
+		BiddingZoneDomain: DomainCZ,
+		PeriodStart:       genTime("201512312300"),
+		PeriodEnd:         genTime("201712312300"),
+	})
+	assert.False(t, it.Next())
+	assert.Nil(t, it.Err())
+	assert.Len(t, requests(), 2)
+}
+
+// outageRequest records the period start and offset of a request to the
+// server returned by newOutageServer.
+type outageRequest struct {
+	start  string
+	offset string
+}
+
+// newOutageServer serves total outage documents per requested window in
+// pages of maxDocumentsPerPage. The mRID of a document is the window start
+// and its index.
+func newOutageServer(total int) (*httptest.Server, func() []outageRequest) {
+	var mu sync.Mutex
+	var requests []outageRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := r.URL.Query().Get(ParameterPeriodStart)
+		mu.Lock()
+		requests = append(requests, outageRequest{start, r.URL.Query().Get(ParameterOffset)})
+		mu.Unlock()
+		offset, _ := strconv.Atoi(r.URL.Query().Get(ParameterOffset))
+		if offset >= total {
+			w.Write([]byte(noMatchingDataAcknowledgement))
+			return
+		}
+
+		buf := new(bytes.Buffer)
+		zw := zip.NewWriter(buf)
+		for i := offset; i < offset+maxDocumentsPerPage && i < total; i++ {
+			f, _ := zw.Create(strconv.Itoa(i) + ".xml")
+			f.Write([]byte("<Unavailability_MarketDocument><mRID>" + start + "/" + strconv.Itoa(i) + "</mRID></Unavailability_MarketDocument>"))
+		}
+		zw.Close()
+		w.Header().Set("Content-Type", "application/zip")
+		w.Write(buf.Bytes())
+	}))
+	return server, func() []outageRequest {
+		mu.Lock()
+		defer mu.Unlock()
+		return append([]outageRequest(nil), requests...)
+	}
+}
+
+func TestUnavailabilityPagesEveryWindow(t *testing.T) {
+	server, requests := newOutageServer(150)
+	defer server.Close()
+
+	c := NewEntsoeClient("token", WithBaseURL(server.URL))
+	docs, err := c.GetUnavailabilityOfGenerationUnits(
+		DomainCZ,
+		genTime("201512312300"),
+		genTime("201712312300"),
+		nil, nil, nil, nil,
+	)
+	assert.Nil(t, err)
+	assert.Len(t, docs, 300)
+	assert.Equal(t, "201612312300/149", docs[299].MRID)
+	assert.ElementsMatch(t, []outageRequest{
+		{"201512312300", ""}, {"201512312300", "100"},
+		{"201612312300", ""}, {"201612312300", "100"},
+	}, requests())
+}
+
+func TestUnavailabilityOffsetAppliesToFirstWindow(t *testing.T) {
+	server, requests := newOutageServer(150)
+	defer server.Close()
+
+	offset := 120
+	c := NewEntsoeClient("token", WithBaseURL(server.URL))
+	docs, err := c.GetUnavailabilityOfGenerationUnits(
+		DomainCZ,
+		genTime("201512312300"),
+		genTime("201712312300"),
+		nil, nil, nil, &offset,
+	)
+	assert.Nil(t, err)
+	assert.Len(t, docs, 30+150)
+	assert.Equal(t, "201512312300/120", docs[0].MRID)
+	assert.ElementsMatch(t, []outageRequest{
+		{"201512312300", "120"},
+		{"201612312300", ""}, {"201612312300", "100"},
+	}, requests())
+}
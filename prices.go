@@ -0,0 +1,151 @@
+package goentsoe
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+)
+
+// Price is the price of the interval [Start, End).
+type Price struct {
+	Start  time.Time
+	End    time.Time
+	Amount float64
+}
+
+// PriceCurve holds the prices of a zone over a period, e.g. a delivery day.
+type PriceCurve struct {
+	Zone DomainType
+	// Currency and Unit qualify the amounts, e.g. EUR per MWH.
+	Currency string
+	Unit     string
+	// Location is the local time of the zone. It decides which prices are
+	// peak prices.
+	Location *time.Location
+	// Prices are ordered by time and do not overlap. Where the document
+	// holds prices in several resolutions the finest one is used, so prices
+	// of different length may follow each other.
+	Prices []Price
+}
+
+// DayAheadPriceCurve returns the day-ahead prices of zone for the delivery
+// day.
+func (c *EntsoeClient) DayAheadPriceCurve(ctx context.Context, zone DomainType, day Date) (*PriceCurve, error) {
+	loc, err := AreaLocation(zone)
+	if err != nil {
+		return nil, err
+	}
+	start, end := day.In(loc)
+	doc, err := c.GetDayAheadPricesCtx(ctx, zone, start, end)
+	if err != nil {
+		return nil, err
+	}
+	series, err := ConvertPublicationMarketDocument(doc)
+	if err != nil {
+		return nil, err
+	}
+	curve, err := NewPriceCurve(series, start, end)
+	if err != nil {
+		return nil, err
+	}
+	curve.Zone = zone
+	curve.Location = loc
+	return curve, nil
+}
+
+// NewPriceCurve merges the prices of series within [start, end) into a
+// curve. Prices of finer resolution take precedence over overlapping ones of
+// coarser resolution, and among series of the same resolution the first one
+// wins. All series must have the same currency and price unit.
+func NewPriceCurve(series []TimeSeries, start, end time.Time) (*PriceCurve, error) {
+	curve := &PriceCurve{Location: time.UTC}
+	var candidates []Price
+	for _, ts := range series {
+		if ts.Currency == "" {
+			continue
+		}
+		if curve.Currency == "" {
+			curve.Currency = ts.Currency
+			curve.Unit = ts.PriceUnit
+		}
+		if ts.Currency != curve.Currency || ts.PriceUnit != curve.Unit {
+			return nil, fmt.Errorf("time series %s: price in %s/%s, want %s/%s", ts.MRID, ts.Currency, ts.PriceUnit, curve.Currency, curve.Unit)
+		}
+		for _, p := range ts.Points {
+			if p.Start.Before(start) || p.End.After(end) {
+				continue
+			}
+			candidates = append(candidates, Price{Start: p.Start, End: p.End, Amount: p.Value})
+		}
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].End.Sub(candidates[i].Start) < candidates[j].End.Sub(candidates[j].Start)
+	})
+	for _, candidate := range candidates {
+		if !curve.overlaps(candidate) {
+			curve.Prices = append(curve.Prices, candidate)
+		}
+	}
+	sort.Slice(curve.Prices, func(i, j int) bool {
+		return curve.Prices[i].Start.Before(curve.Prices[j].Start)
+	})
+	return curve, nil
+}
+
+func (c *PriceCurve) overlaps(p Price) bool {
+	for _, q := range c.Prices {
+		if p.Start.Before(q.End) && q.Start.Before(p.End) {
+			return true
+		}
+	}
+	return false
+}
+
+// Base returns the time weighted average of all prices, or NaN if the curve
+// is empty.
+func (c *PriceCurve) Base() float64 {
+	return c.average(func(Price) bool { return true })
+}
+
+// Peak returns the time weighted average of the prices from 8:00 to 20:00
+// local time on weekdays, or NaN if the curve has no such prices.
+func (c *PriceCurve) Peak() float64 {
+	return c.average(c.isPeak)
+}
+
+// OffPeak returns the time weighted average of the prices outside of the
+// peak hours, or NaN if the curve has no such prices.
+func (c *PriceCurve) OffPeak() float64 {
+	return c.average(func(p Price) bool { return !c.isPeak(p) })
+}
+
+func (c *PriceCurve) isPeak(p Price) bool {
+	loc := c.Location
+	if loc == nil {
+		loc = time.UTC
+	}
+	t := p.Start.In(loc)
+	if t.Weekday() == time.Saturday || t.Weekday() == time.Sunday {
+		return false
+	}
+	return t.Hour() >= 8 && t.Hour() < 20
+}
+
+func (c *PriceCurve) average(include func(Price) bool) float64 {
+	var sum, hours float64
+	for _, p := range c.Prices {
+		if !include(p) {
+			continue
+		}
+		h := p.End.Sub(p.Start).Hours()
+		sum += p.Amount * h
+		hours += h
+	}
+	if hours == 0 {
+		return math.NaN()
+	}
+	return sum / hours
+}
@@ -0,0 +1,77 @@
+package goentsoe
+
+import (
+	"context"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewPriceCurvePrefersFinerResolution(t *testing.T) {
+	loc, err := AreaLocation(DomainDELU)
+	assert.Nil(t, err)
+	start, end := Date{2025, time.October, 1}.In(loc)
+
+	hourly := TimeSeries{MRID: "1", Currency: "EUR", PriceUnit: "MWH"}
+	for h := 0; h < 24; h++ {
+		hourly.Points = append(hourly.Points, Point{
+			Start: start.Add(time.Duration(h) * time.Hour),
+			End:   start.Add(time.Duration(h+1) * time.Hour),
+			Value: float64(h),
+		})
+	}
+	quarterly := TimeSeries{MRID: "2", Currency: "EUR", PriceUnit: "MWH"}
+	for q := 0; q < 48; q++ {
+		quarterly.Points = append(quarterly.Points, Point{
+			Start: start.Add(time.Duration(q) * 15 * time.Minute),
+			End:   start.Add(time.Duration(q+1) * 15 * time.Minute),
+			Value: 100,
+		})
+	}
+	// A point of the previous day is ignored.
+	hourly.Points = append(hourly.Points, Point{Start: start.Add(-time.Hour), End: start, Value: 1000})
+
+	curve, err := NewPriceCurve([]TimeSeries{hourly, quarterly}, start, end)
+	assert.Nil(t, err)
+	curve.Location = loc
+	assert.Equal(t, "EUR", curve.Currency)
+	assert.Equal(t, "MWH", curve.Unit)
+	assert.Len(t, curve.Prices, 60)
+	assert.Equal(t, start, curve.Prices[0].Start)
+	assert.Equal(t, 15*time.Minute, curve.Prices[47].End.Sub(curve.Prices[47].Start))
+	assert.Equal(t, Price{Start: start.Add(12 * time.Hour), End: start.Add(13 * time.Hour), Amount: 12}, curve.Prices[48])
+
+	assert.InDelta(t, 58.75, curve.Base(), 1e-9)
+	assert.InDelta(t, 524.0/12, curve.Peak(), 1e-9)
+	assert.InDelta(t, 886.0/12, curve.OffPeak(), 1e-9)
+}
+
+func TestNewPriceCurveRejectsMixedCurrencies(t *testing.T) {
+	_, err := NewPriceCurve([]TimeSeries{
+		{MRID: "1", Currency: "EUR", PriceUnit: "MWH"},
+		{MRID: "2", Currency: "GBP", PriceUnit: "MWH"},
+	}, time.Time{}, time.Time{})
+	assert.EqualError(t, err, "time series 2: price in GBP/MWH, want EUR/MWH")
+}
+
+func TestDayAheadPriceCurve(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "201512312300", r.URL.Query().Get(ParameterPeriodStart))
+		w.Write([]byte(dayAheadPricesDocument))
+	}))
+	defer server.Close()
+
+	c := NewEntsoeClient("token", WithBaseURL(server.URL))
+	curve, err := c.DayAheadPriceCurve(context.Background(), DomainCZ, Date{2016, time.January, 1})
+	assert.Nil(t, err)
+	assert.Equal(t, DomainCZ, curve.Zone)
+	assert.Len(t, curve.Prices, 2)
+	assert.InDelta(t, 7.625, curve.Base(), 1e-9)
+	// 2016-01-01 is a Friday, but both prices lie before 8:00.
+	assert.True(t, math.IsNaN(curve.Peak()))
+	assert.InDelta(t, 7.625, curve.OffPeak(), 1e-9)
+}
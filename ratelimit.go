@@ -0,0 +1,100 @@
+package goentsoe
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// The API bans security tokens that send more than 400 requests per minute.
+// The default limiter stays below that even when its burst is used up at the
+// start of a minute.
+const (
+	DefaultRateLimitRequests = 380
+	DefaultRateLimitBurst    = 20
+)
+
+// RateLimiter is a token bucket limiting the rate of requests. It is safe for
+// concurrent use and can be shared by several clients using the same token.
+type RateLimiter struct {
+	mu       sync.Mutex
+	interval time.Duration // time to refill one token
+	burst    float64
+	tokens   float64
+	last     time.Time
+	now      func() time.Time
+}
+
+// NewRateLimiter returns a limiter allowing requests per interval per on
+// average, with bursts of up to burst requests.
+func NewRateLimiter(requests int, per time.Duration, burst int) *RateLimiter {
+	if requests < 1 {
+		requests = 1
+	}
+	if burst < 1 {
+		burst = 1
+	}
+	return &RateLimiter{
+		interval: per / time.Duration(requests),
+		burst:    float64(burst),
+		tokens:   float64(burst),
+		now:      time.Now,
+	}
+}
+
+// Wait blocks until a request may be sent or ctx is done.
+func (l *RateLimiter) Wait(ctx context.Context) error {
+	delay := l.reserve()
+	if delay <= 0 {
+		return nil
+	}
+	if err := sleep(ctx, delay); err != nil {
+		l.cancel()
+		return err
+	}
+	return nil
+}
+
+// reserve takes a token and returns how long the caller has to wait before
+// using it.
+func (l *RateLimiter) reserve() time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	if !l.last.IsZero() && l.interval > 0 {
+		l.tokens += float64(now.Sub(l.last)) / float64(l.interval)
+		if l.tokens > l.burst {
+			l.tokens = l.burst
+		}
+	}
+	l.last = now
+	l.tokens--
+	if l.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-l.tokens * float64(l.interval))
+}
+
+// cancel returns a token taken by reserve that was not used.
+func (l *RateLimiter) cancel() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.tokens++
+}
+
+// WithRateLimit replaces the default rate limit of the client with one
+// allowing requests per interval per and bursts of burst requests.
+func WithRateLimit(requests int, per time.Duration, burst int) Option {
+	return func(c *EntsoeClient) {
+		c.rateLimiter = NewRateLimiter(requests, per, burst)
+	}
+}
+
+// WithRateLimiter makes the client use limiter, which may be shared with other
+// clients. A nil limiter disables rate limiting.
+func WithRateLimiter(limiter *RateLimiter) Option {
+	return func(c *EntsoeClient) {
+		c.rateLimiter = limiter
+	}
+}
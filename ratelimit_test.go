@@ -0,0 +1,35 @@
+package goentsoe
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRateLimiterReserve(t *testing.T) {
+	now := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	l := NewRateLimiter(60, time.Minute, 2)
+	l.now = func() time.Time { return now }
+
+	assert.Equal(t, time.Duration(0), l.reserve())
+	assert.Equal(t, time.Duration(0), l.reserve())
+	assert.Equal(t, time.Second, l.reserve())
+	assert.Equal(t, 2*time.Second, l.reserve())
+
+	now = now.Add(10 * time.Second)
+	assert.Equal(t, time.Duration(0), l.reserve())
+	assert.Equal(t, time.Duration(0), l.reserve())
+	assert.Equal(t, time.Second, l.reserve())
+}
+
+func TestRateLimiterWaitHonoursContext(t *testing.T) {
+	l := NewRateLimiter(1, time.Hour, 1)
+	assert.Nil(t, l.Wait(context.Background()))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	assert.ErrorIs(t, l.Wait(ctx), context.DeadlineExceeded)
+	assert.InDelta(t, 0, l.tokens, 1e-6)
+}
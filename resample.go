@@ -0,0 +1,111 @@
+package goentsoe
+
+import (
+	"math"
+	"sort"
+	"time"
+)
+
+// coarsestResolution returns the length of the longest point of series.
+func coarsestResolution(series []TimeSeries) time.Duration {
+	var res time.Duration
+	for _, ts := range series {
+		for _, p := range ts.Points {
+			if d := p.End.Sub(p.Start); d > res {
+				res = d
+			}
+		}
+	}
+	return res
+}
+
+// resample averages the points of series over intervals of the given
+// resolution, weighted by their length. The intervals are aligned to the wall
+// clock of loc, and a resolution of a day or more makes them delivery days of
+// loc, which last 23 to 25 hours. Intervals only partly covered by points are
+// NaN.
+func resample(series []TimeSeries, resolution time.Duration, loc *time.Location) map[time.Time]float64 {
+	type interval struct {
+		sum     float64
+		covered time.Duration
+		length  time.Duration
+	}
+	intervals := make(map[time.Time]*interval)
+	for _, ts := range series {
+		for _, p := range ts.Points {
+			start, length := intervalOf(p.Start, resolution, loc)
+			iv, ok := intervals[start]
+			if !ok {
+				iv = &interval{length: length}
+				intervals[start] = iv
+			}
+			d := p.End.Sub(p.Start)
+			iv.sum += p.Value * float64(d)
+			iv.covered += d
+		}
+	}
+	res := make(map[time.Time]float64, len(intervals))
+	for start, iv := range intervals {
+		if iv.covered != iv.length {
+			res[start] = math.NaN()
+			continue
+		}
+		res[start] = iv.sum / float64(iv.length)
+	}
+	return res
+}
+
+// intervalOf returns the start, in UTC, and the length of the interval of
+// the given resolution containing t, aligned to the wall clock of loc.
+func intervalOf(t time.Time, resolution time.Duration, loc *time.Location) (time.Time, time.Duration) {
+	local := t.In(loc)
+	if resolution >= 23*time.Hour {
+		start, end := DateOf(local).In(loc)
+		return start, end.Sub(start)
+	}
+	_, offset := local.Zone()
+	shift := time.Duration(offset) * time.Second
+	return t.UTC().Add(shift).Truncate(resolution).Add(-shift), resolution
+}
+
+// seriesLocation returns the time zone of the first area of series found in
+// the area registry, UTC if there is none.
+func seriesLocation(series []TimeSeries) *time.Location {
+	var domains []DomainType
+	for _, ts := range series {
+		domains = append(domains, ts.Domain, ts.InDomain, ts.OutDomain)
+	}
+	return periodLocation(domains...)
+}
+
+// intervalStarts returns the starts of all intervals of columns in ascending
+// order.
+func intervalStarts(columns ...map[time.Time]float64) []time.Time {
+	seen := make(map[time.Time]bool)
+	var res []time.Time
+	for _, column := range columns {
+		for t := range column {
+			if !seen[t] {
+				seen[t] = true
+				res = append(res, t)
+			}
+		}
+	}
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].Before(res[j])
+	})
+	return res
+}
+
+// alignColumn returns the values of column at times, NaN where it has none.
+func alignColumn(times []time.Time, column map[time.Time]float64) []float64 {
+	res := make([]float64, len(times))
+	for i, t := range times {
+		v, ok := column[t]
+		if !ok {
+			v = math.NaN()
+		}
+		res[i] = v
+	}
+	return res
+}
@@ -0,0 +1,143 @@
+package goentsoe
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+)
+
+// ResidualLoad is the actual total load of a zone minus its wind and solar
+// generation, the load left to be covered by dispatchable generation and
+// imports.
+type ResidualLoad struct {
+	Zone DomainType
+	// Unit is the measure unit of the values, usually MAW.
+	Unit string
+	// Resolution is the length of every row. Series published in a finer
+	// resolution are averaged to the coarsest resolution of all inputs.
+	Resolution time.Duration
+	// Times holds the start of every row in ascending order. There is a row
+	// for every interval with published load.
+	Times []time.Time
+	Load  []float64
+	// Wind is the onshore plus the offshore wind generation.
+	Wind  []float64
+	Solar []float64
+	// Residual is Load minus Wind and Solar.
+	Residual []float64
+	// RenewableShare is Wind plus Solar as a fraction of Load.
+	RenewableShare []float64
+	// Missing holds the start of every row for which an input lacks data.
+	// The load, generation and derived values of such a row are NaN.
+	Missing []time.Time
+}
+
+// ResidualLoad fetches the actual total load and the actual generation per
+// production type of zone and computes the residual load. It returns
+// ErrNoMatchingData if there is no data for either of them.
+func (c *EntsoeClient) ResidualLoad(ctx context.Context, zone DomainType, periodStart, periodEnd time.Time) (*ResidualLoad, error) {
+	var load, generation []TimeSeries
+	err := fanOut(ctx, 2, 2, func(ctx context.Context, i int) error {
+		if i == 1 {
+			var err error
+			generation, err = c.generationPerType(ctx, zone, periodStart, periodEnd)
+			return err
+		}
+		doc, err := c.GetActualTotalLoadCtx(ctx, zone, periodStart, periodEnd)
+		if err != nil {
+			return err
+		}
+		load, err = ConvertGLMarketDocument(doc)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	res, err := NewResidualLoad(load, generation)
+	if err != nil {
+		return nil, err
+	}
+	res.Zone = zone
+	return res, nil
+}
+
+// NewResidualLoad computes the residual load from actual total load series
+// and generation per type series. Generation series other than wind and
+// solar as well as consumption series are ignored. A production type without
+// any series counts as zero generation, since zones without offshore wind do
+// not publish it. An interval is missing if an input covers only part of it.
+func NewResidualLoad(load, generation []TimeSeries) (*ResidualLoad, error) {
+	var solar, offshore, onshore []TimeSeries
+	for _, ts := range generation {
+		if ts.InDomain == "" && ts.OutDomain != "" {
+			continue
+		}
+		switch ts.PsrType {
+		case PsrTypeSolar:
+			solar = append(solar, ts)
+		case PsrTypeWindOffshore:
+			offshore = append(offshore, ts)
+		case PsrTypeWindOnshore:
+			onshore = append(onshore, ts)
+		}
+	}
+
+	r := &ResidualLoad{}
+	for _, inputs := range [][]TimeSeries{load, solar, offshore, onshore} {
+		for _, ts := range inputs {
+			if r.Unit == "" {
+				r.Unit = ts.Unit
+			}
+			if ts.Unit != r.Unit {
+				return nil, fmt.Errorf("time series %s: quantity in %s, want %s", ts.MRID, ts.Unit, r.Unit)
+			}
+		}
+		if d := coarsestResolution(inputs); d > r.Resolution {
+			r.Resolution = d
+		}
+	}
+
+	loc := seriesLocation(load)
+	loadColumn := resample(load, r.Resolution, loc)
+	r.Times = intervalStarts(loadColumn)
+	r.Load = alignColumn(r.Times, loadColumn)
+	r.Solar = r.generationColumn(solar, loc)
+	offshoreColumn, onshoreColumn := r.generationColumn(offshore, loc), r.generationColumn(onshore, loc)
+	r.Wind = make([]float64, len(r.Times))
+	r.Residual = make([]float64, len(r.Times))
+	r.RenewableShare = make([]float64, len(r.Times))
+	for i, t := range r.Times {
+		r.Wind[i] = offshoreColumn[i] + onshoreColumn[i]
+		r.Residual[i] = r.Load[i] - r.Wind[i] - r.Solar[i]
+		r.RenewableShare[i] = (r.Wind[i] + r.Solar[i]) / r.Load[i]
+		if math.IsNaN(r.Residual[i]) {
+			r.Missing = append(r.Missing, t)
+		}
+	}
+	return r, nil
+}
+
+// generationColumn returns the generation of a production type per row,
+// zero throughout if the type has no series at all.
+func (r *ResidualLoad) generationColumn(series []TimeSeries, loc *time.Location) []float64 {
+	if len(series) == 0 {
+		return make([]float64, len(r.Times))
+	}
+	return alignColumn(r.Times, resample(series, r.Resolution, loc))
+}
+
+// Ramps returns the change of the residual load from the previous row per
+// row. It is NaN for the first row and for rows not directly following the
+// previous one.
+func (r *ResidualLoad) Ramps() []float64 {
+	res := make([]float64, len(r.Times))
+	for i := range res {
+		if i == 0 || r.Times[i].Sub(r.Times[i-1]) != r.Resolution {
+			res[i] = math.NaN()
+			continue
+		}
+		res[i] = r.Residual[i] - r.Residual[i-1]
+	}
+	return res
+}
@@ -0,0 +1,110 @@
+package goentsoe
+
+import (
+	"context"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func testSeries(mrid string, psrType PsrType, start time.Time, resolution time.Duration, values ...float64) TimeSeries {
+	ts := TimeSeries{MRID: mrid, PsrType: psrType, Unit: "MAW"}
+	for i, v := range values {
+		if math.IsNaN(v) {
+			continue
+		}
+		ts.Points = append(ts.Points, Point{
+			Start: start.Add(time.Duration(i) * resolution),
+			End:   start.Add(time.Duration(i+1) * resolution),
+			Value: v,
+		})
+	}
+	return ts
+}
+
+func TestNewResidualLoad(t *testing.T) {
+	start := time.Date(2023, 6, 1, 10, 0, 0, 0, time.UTC)
+	nan := math.NaN()
+	load := []TimeSeries{testSeries("1", "", start, time.Hour, 1000, 1200, 1100, nan, 900)}
+	generation := []TimeSeries{
+		testSeries("2", PsrTypeSolar, start, 15*time.Minute,
+			100, 200, 300, 400,
+			400, 400, 400, 400,
+			100, nan, 100, 100,
+			0, 0, 0, 0,
+			0, 0, 0, 0),
+		testSeries("3", PsrTypeWindOnshore, start, time.Hour, 250, 200, 400, 0, 450),
+		testSeries("4", PsrTypeBiomass, start, time.Hour, 50, 50, 50, 50, 50),
+	}
+
+	r, err := NewResidualLoad(load, generation)
+	assert.Nil(t, err)
+	assert.Equal(t, "MAW", r.Unit)
+	assert.Equal(t, time.Hour, r.Resolution)
+	assert.Equal(t, []time.Time{start, start.Add(time.Hour), start.Add(2 * time.Hour), start.Add(4 * time.Hour)}, r.Times)
+	assert.Equal(t, []float64{250, 200, 400, 450}, r.Wind)
+	assert.Equal(t, 250.0, r.Solar[0])
+	assert.True(t, math.IsNaN(r.Solar[2]))
+	assert.Equal(t, 500.0, r.Residual[0])
+	assert.Equal(t, 600.0, r.Residual[1])
+	assert.True(t, math.IsNaN(r.Residual[2]))
+	assert.Equal(t, 450.0, r.Residual[3])
+	assert.Equal(t, 0.5, r.RenewableShare[0])
+	assert.Equal(t, []time.Time{start.Add(2 * time.Hour)}, r.Missing)
+
+	ramps := r.Ramps()
+	assert.True(t, math.IsNaN(ramps[0]))
+	assert.Equal(t, 100.0, ramps[1])
+	assert.True(t, math.IsNaN(ramps[2]))
+	// The row of 13:00 has no load, so there is no ramp into 14:00.
+	assert.True(t, math.IsNaN(ramps[3]))
+}
+
+func TestNewResidualLoadAlignsToLocalDays(t *testing.T) {
+	// Daylight saving time ends on 29 October 2023, a 25 hour day in Germany.
+	start := time.Date(2023, 10, 28, 22, 0, 0, 0, time.UTC)
+	hourly := make([]float64, 25)
+	for i := range hourly {
+		hourly[i] = 100
+	}
+	load := testSeries("1", "", start, 25*time.Hour, 1000)
+	load.Domain = DomainDELU
+	r, err := NewResidualLoad([]TimeSeries{load}, []TimeSeries{testSeries("2", PsrTypeWindOnshore, start, time.Hour, hourly...)})
+	assert.Nil(t, err)
+	assert.Equal(t, []time.Time{start}, r.Times)
+	assert.Equal(t, []float64{900}, r.Residual)
+	assert.Empty(t, r.Missing)
+}
+
+func TestNewResidualLoadRejectsMixedUnits(t *testing.T) {
+	start := time.Date(2023, 6, 1, 10, 0, 0, 0, time.UTC)
+	solar := testSeries("2", PsrTypeSolar, start, time.Hour, 100)
+	solar.Unit = "MWH"
+	_, err := NewResidualLoad([]TimeSeries{testSeries("1", "", start, time.Hour, 1000)}, []TimeSeries{solar})
+	assert.EqualError(t, err, "time series 2: quantity in MWH, want MAW")
+}
+
+func TestResidualLoad(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch DocumentType(r.URL.Query().Get(ParameterDocumentType)) {
+		case DocumentTypeSystemTotalLoad:
+			w.Write([]byte(actualTotalLoadDocument))
+		default:
+			w.Write([]byte(noMatchingDataAcknowledgement))
+		}
+	}))
+	defer server.Close()
+
+	c := NewEntsoeClient("token", WithBaseURL(server.URL))
+	_, err := c.ResidualLoad(
+		context.Background(),
+		DomainCZ,
+		time.Date(2015, 12, 31, 23, 0, 0, 0, time.UTC),
+		time.Date(2016, 1, 1, 2, 0, 0, 0, time.UTC),
+	)
+	assert.ErrorIs(t, err, ErrNoMatchingData)
+}
@@ -0,0 +1,168 @@
+package goentsoe
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math/rand"
+	"net"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// RetryPolicy controls how failed requests are retried. All requests made by
+// the client are idempotent GETs, so every attempt is safe to repeat.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts, including the first one.
+	// Values below 1 are treated as 1, i.e. no retries.
+	MaxAttempts int
+	// BaseDelay is the backoff before the second attempt. It doubles with
+	// every further attempt, up to MaxDelay.
+	BaseDelay time.Duration
+	// MaxDelay caps the backoff and any Retry-After value sent by the server.
+	MaxDelay time.Duration
+	// RetryStatus lists the HTTP status codes that are retried.
+	RetryStatus map[int]bool
+	// RetryNetworkErrors retries connection failures such as resets and
+	// timeouts of a single attempt.
+	RetryNetworkErrors bool
+	// OnAttempt, if set, is called after every failed attempt that is going to
+	// be retried.
+	OnAttempt func(RetryAttempt)
+}
+
+// RetryAttempt describes a failed attempt passed to RetryPolicy.OnAttempt.
+type RetryAttempt struct {
+	// Attempt is the number of the failed attempt, starting at 1.
+	Attempt int
+	// StatusCode is the HTTP status of the response, or 0 on network errors.
+	StatusCode int
+	// Err is the error of the failed attempt.
+	Err error
+	// Delay is the time waited before the next attempt.
+	Delay time.Duration
+}
+
+// DefaultRetryPolicy retries rate limiting, server overload and network
+// errors up to four times.
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts: 5,
+		BaseDelay:   time.Second,
+		MaxDelay:    time.Minute,
+		RetryStatus: map[int]bool{
+			http.StatusTooManyRequests:     true,
+			http.StatusInternalServerError: true,
+			http.StatusBadGateway:          true,
+			http.StatusServiceUnavailable:  true,
+			http.StatusGatewayTimeout:      true,
+		},
+		RetryNetworkErrors: true,
+	}
+}
+
+// WithRetryPolicy makes the client retry failed requests according to policy.
+// Without this option the client uses DefaultRetryPolicy; pass RetryPolicy{}
+// to disable retries.
+func WithRetryPolicy(policy RetryPolicy) Option {
+	return func(c *EntsoeClient) {
+		c.retryPolicy = policy
+	}
+}
+
+// shouldRetry reports whether an attempt that failed with statusCode (0 for
+// transport errors) and err may be repeated. The caller must have checked that
+// the context of the request is not done, so a deadline exceeded here is the
+// timeout of the single attempt.
+func (p *RetryPolicy) shouldRetry(statusCode int, err error) bool {
+	if errors.Is(err, ErrTooManyRequests) {
+		return p.RetryStatus[http.StatusTooManyRequests]
+	}
+	if statusCode != 0 {
+		return p.RetryStatus[statusCode]
+	}
+	if !p.RetryNetworkErrors || err == nil {
+		return false
+	}
+	if errors.Is(err, context.Canceled) {
+		return false
+	}
+	if errors.Is(err, context.DeadlineExceeded) {
+		return true
+	}
+	var netErr net.Error
+	if errors.As(err, &netErr) {
+		return true
+	}
+	return errors.Is(err, io.ErrUnexpectedEOF)
+}
+
+// delay returns the wait before the attempt following attempt, which failed
+// with header. A Retry-After header takes precedence over the backoff.
+func (p *RetryPolicy) delay(attempt int, header http.Header) time.Duration {
+	if d, ok := parseRetryAfter(header, time.Now()); ok {
+		if p.MaxDelay > 0 && d > p.MaxDelay {
+			return p.MaxDelay
+		}
+		return d
+	}
+	backoff := p.BaseDelay
+	for i := 1; i < attempt && (p.MaxDelay <= 0 || backoff < p.MaxDelay); i++ {
+		backoff *= 2
+	}
+	if p.MaxDelay > 0 && backoff > p.MaxDelay {
+		backoff = p.MaxDelay
+	}
+	if backoff <= 0 {
+		return 0
+	}
+	// full jitter between half and the whole backoff
+	return backoff/2 + time.Duration(jitter.Int63n(int64(backoff/2)+1))
+}
+
+// parseRetryAfter reads a Retry-After header given either in seconds or as an
+// HTTP date.
+func parseRetryAfter(header http.Header, now time.Time) (time.Duration, bool) {
+	value := header.Get("Retry-After")
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if t, err := http.ParseTime(value); err == nil {
+		if d := t.Sub(now); d > 0 {
+			return d, true
+		}
+		return 0, true
+	}
+	return 0, false
+}
+
+// sleep waits for d or until ctx is done.
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+var jitter = &lockedRand{r: rand.New(rand.NewSource(time.Now().UnixNano()))}
+
+// lockedRand is a math/rand source that is safe for concurrent use.
+type lockedRand struct {
+	mu sync.Mutex
+	r  *rand.Rand
+}
+
+func (l *lockedRand) Int63n(n int64) int64 {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.r.Int63n(n)
+}
@@ -0,0 +1,166 @@
+package goentsoe
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRetryPolicy(t *testing.T) {
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&requests, 1) < 3 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Write([]byte(emptyGLMarketDocument))
+	}))
+	defer server.Close()
+
+	var attempts []RetryAttempt
+	policy := DefaultRetryPolicy()
+	policy.OnAttempt = func(a RetryAttempt) {
+		attempts = append(attempts, a)
+	}
+	c := NewEntsoeClient("token", WithBaseURL(server.URL), WithRetryPolicy(policy))
+	doc, err := c.GetActualTotalLoad(
+		DomainCZ,
+		genTime("201512312300"),
+		genTime("201612312300"),
+	)
+	assert.Nil(t, err)
+	assert.NotNil(t, doc)
+	assert.Equal(t, int32(3), requests)
+	assert.Len(t, attempts, 2)
+	assert.Equal(t, http.StatusServiceUnavailable, attempts[0].StatusCode)
+	assert.Equal(t, time.Duration(0), attempts[0].Delay)
+}
+
+func TestClientRetriesByDefault(t *testing.T) {
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&requests, 1) == 1 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.Write([]byte(emptyGLMarketDocument))
+	}))
+	defer server.Close()
+
+	c := NewEntsoeClient("token", WithBaseURL(server.URL))
+	_, err := c.GetActualTotalLoad(
+		DomainCZ,
+		genTime("201512312300"),
+		genTime("201612312300"),
+	)
+	assert.Nil(t, err)
+	assert.Equal(t, int32(2), requests)
+
+	atomic.StoreInt32(&requests, 0)
+	c = NewEntsoeClient("token", WithBaseURL(server.URL), WithRetryPolicy(RetryPolicy{}))
+	_, err = c.GetActualTotalLoad(
+		DomainCZ,
+		genTime("201512312300"),
+		genTime("201612312300"),
+	)
+	assert.ErrorIs(t, err, ErrTooManyRequests)
+	assert.Equal(t, int32(1), requests)
+}
+
+func TestRetryPolicyGivesUp(t *testing.T) {
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(noMatchingDataAcknowledgement))
+	}))
+	defer server.Close()
+
+	c := NewEntsoeClient("token", WithBaseURL(server.URL), WithRetryPolicy(DefaultRetryPolicy()))
+	_, err := c.GetActualTotalLoad(
+		DomainCZ,
+		genTime("201512312300"),
+		genTime("201612312300"),
+	)
+	assert.ErrorIs(t, err, ErrNoMatchingData)
+	assert.Equal(t, int32(1), requests)
+}
+
+func TestRetryPolicyRetriesAttemptTimeouts(t *testing.T) {
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&requests, 1) == 1 {
+			<-r.Context().Done()
+			return
+		}
+		w.Write([]byte(emptyGLMarketDocument))
+	}))
+	defer server.Close()
+
+	policy := DefaultRetryPolicy()
+	policy.BaseDelay = 0
+	c := NewEntsoeClient("token", WithBaseURL(server.URL), WithRetryPolicy(policy), WithTimeout(50*time.Millisecond))
+	_, err := c.GetActualTotalLoad(
+		DomainCZ,
+		genTime("201512312300"),
+		genTime("201612312300"),
+	)
+	assert.Nil(t, err)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&requests))
+}
+
+func TestRetryPolicyStopsAtCallerDeadline(t *testing.T) {
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		<-r.Context().Done()
+	}))
+	defer server.Close()
+
+	policy := DefaultRetryPolicy()
+	policy.BaseDelay = 0
+	c := NewEntsoeClient("token", WithBaseURL(server.URL), WithRetryPolicy(policy))
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	_, err := c.GetActualTotalLoadCtx(
+		ctx,
+		DomainCZ,
+		genTime("201512312300"),
+		genTime("201612312300"),
+	)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&requests))
+}
+
+func TestRetryPolicyDelay(t *testing.T) {
+	policy := RetryPolicy{BaseDelay: time.Second, MaxDelay: 5 * time.Second}
+	for attempt, max := range map[int]time.Duration{1: time.Second, 2: 2 * time.Second, 3: 4 * time.Second, 6: 5 * time.Second} {
+		d := policy.delay(attempt, http.Header{})
+		assert.True(t, d >= max/2 && d <= max, "attempt %d: %s", attempt, d)
+	}
+
+	header := http.Header{}
+	header.Set("Retry-After", "3")
+	assert.Equal(t, 3*time.Second, policy.delay(1, header))
+	header.Set("Retry-After", "120")
+	assert.Equal(t, 5*time.Second, policy.delay(1, header))
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	header := http.Header{}
+	_, ok := parseRetryAfter(header, now)
+	assert.False(t, ok)
+
+	header.Set("Retry-After", now.Add(30*time.Second).Format(http.TimeFormat))
+	d, ok := parseRetryAfter(header, now)
+	assert.True(t, ok)
+	assert.Equal(t, 30*time.Second, d)
+}
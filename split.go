@@ -0,0 +1,125 @@
+package goentsoe
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"time"
+)
+
+// maxPeriodDays lists the document types whose requests may span at most the
+// given number of days. All other document types are limited to one year.
+var maxPeriodDays = map[DocumentType]int{
+	DocumentTypeActualGeneration:     1,
+	DocumentTypeFlowBasedAllocations: 1,
+	DocumentTypeBidDocument:          1,
+}
+
+// WithPeriodSplitting enables or disables splitting of requests whose period
+// exceeds the range the API accepts for the document type. Splitting is
+// enabled by default.
+func WithPeriodSplitting(enabled bool) Option {
+	return func(c *EntsoeClient) {
+		c.disablePeriodSplitting = !enabled
+	}
+}
+
+// WithConcurrency sets how many windows of a split request are fetched in
+// parallel. Parallel requests still share the client's rate limiter.
+func WithConcurrency(n int) Option {
+	return func(c *EntsoeClient) {
+		c.concurrency = n
+	}
+}
+
+// nextWindowEnd returns the end of the longest allowed window starting at
+// start for documentType.
+func nextWindowEnd(documentType DocumentType, start time.Time) time.Time {
+	if days, ok := maxPeriodDays[documentType]; ok {
+		return start.AddDate(0, 0, days)
+	}
+	return start.AddDate(1, 0, 0)
+}
+
+// maxDocumentsPerPage is the number of documents the outage endpoints return
+// for a request at most. Further documents are requested with an offset.
+const maxDocumentsPerPage = 100
+
+// splitPeriod returns one copy of params per allowed window of the requested
+// period. Requests without a period, or with a period within the limit, are
+// returned unchanged. An offset applies to the first window only, as it
+// counts documents of the whole result.
+func (c *EntsoeClient) splitPeriod(params url.Values) []url.Values {
+	if c.disablePeriodSplitting {
+		return []url.Values{params}
+	}
+	periodStart, err := time.Parse("200601021504", params.Get(ParameterPeriodStart))
+	if err != nil {
+		return []url.Values{params}
+	}
+	periodEnd, err := time.Parse("200601021504", params.Get(ParameterPeriodEnd))
+	if err != nil {
+		return []url.Values{params}
+	}
+	documentType := DocumentType(params.Get(ParameterDocumentType))
+
+	var windows []url.Values
+	for start := periodStart; start.Before(periodEnd); {
+		end := nextWindowEnd(documentType, start)
+		if end.After(periodEnd) {
+			end = periodEnd
+		}
+		window := url.Values{}
+		for k, v := range params {
+			window[k] = append([]string(nil), v...)
+		}
+		window.Set(ParameterPeriodStart, start.Format("200601021504"))
+		window.Set(ParameterPeriodEnd, end.Format("200601021504"))
+		if len(windows) > 0 {
+			window.Del(ParameterOffset)
+		}
+		windows = append(windows, window)
+		start = end
+	}
+	if len(windows) == 0 {
+		return []url.Values{params}
+	}
+	return windows
+}
+
+// forEachWindow calls fetch for every window index, using up to the
+// configured number of goroutines. Windows without data are tolerated as long
+// as at least one window has data; otherwise the ErrNoMatchingData error is
+// returned. The first other error cancels the remaining windows and is
+// returned rather than the cancellation errors it causes.
+func (c *EntsoeClient) forEachWindow(ctx context.Context, windows int, fetch func(ctx context.Context, i int) error) error {
+	if windows == 1 {
+		return fetch(ctx, 0)
+	}
+
+	errs := make([]error, windows)
+	err := fanOut(ctx, windows, c.concurrency, func(ctx context.Context, i int) error {
+		err := fetch(ctx, i)
+		if errors.Is(err, ErrNoMatchingData) {
+			errs[i] = err
+			return nil
+		}
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	var noData error
+	found := false
+	for _, err := range errs {
+		if err == nil {
+			found = true
+		} else {
+			noData = err
+		}
+	}
+	if !found && noData != nil {
+		return noData
+	}
+	return nil
+}
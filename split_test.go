@@ -0,0 +1,80 @@
+package goentsoe
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSplitPeriod(t *testing.T) {
+	c := NewEntsoeClient("token")
+
+	params := url.Values{}
+	params.Add(ParameterDocumentType, string(DocumentTypeSystemTotalLoad))
+	params.Add(ParameterPeriodStart, "201512312300")
+	params.Add(ParameterPeriodEnd, "201806301200")
+	windows := c.splitPeriod(params)
+	assert.Len(t, windows, 3)
+	assert.Equal(t, "201512312300", windows[0].Get(ParameterPeriodStart))
+	assert.Equal(t, "201612312300", windows[0].Get(ParameterPeriodEnd))
+	assert.Equal(t, "201712312300", windows[2].Get(ParameterPeriodStart))
+	assert.Equal(t, "201806301200", windows[2].Get(ParameterPeriodEnd))
+	assert.Equal(t, "201806301200", params.Get(ParameterPeriodEnd))
+
+	params.Set(ParameterDocumentType, string(DocumentTypeActualGeneration))
+	params.Set(ParameterPeriodEnd, "201601032300")
+	assert.Len(t, c.splitPeriod(params), 3)
+
+	c = NewEntsoeClient("token", WithPeriodSplitting(false))
+	assert.Len(t, c.splitPeriod(params), 1)
+}
+
+func TestRequestMergesSplitPeriods(t *testing.T) {
+	var mu sync.Mutex
+	var periods []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := r.URL.Query().Get(ParameterPeriodStart)
+		mu.Lock()
+		periods = append(periods, start)
+		mu.Unlock()
+		if start == "201612312300" {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(noMatchingDataAcknowledgement))
+			return
+		}
+		w.Write([]byte(`<GL_MarketDocument><TimeSeries><mRID>` + start + `</mRID></TimeSeries></GL_MarketDocument>`))
+	}))
+	defer server.Close()
+
+	c := NewEntsoeClient("token", WithBaseURL(server.URL), WithConcurrency(3))
+	doc, err := c.GetActualTotalLoad(
+		DomainCZ,
+		genTime("201512312300"),
+		genTime("201806301200"),
+	)
+	assert.Nil(t, err)
+	assert.Len(t, periods, 3)
+	assert.Len(t, doc.TimeSeries, 2)
+	assert.Equal(t, "201512312300", doc.TimeSeries[0].MRID)
+	assert.Equal(t, "201712312300", doc.TimeSeries[1].MRID)
+}
+
+func TestForEachWindowReturnsFirstError(t *testing.T) {
+	failure := errors.New("window failed")
+	c := NewEntsoeClient("token", WithConcurrency(2))
+	err := c.forEachWindow(context.Background(), 2, func(ctx context.Context, i int) error {
+		if i == 0 {
+			// Still running when the later window fails.
+			<-ctx.Done()
+			return ctx.Err()
+		}
+		return failure
+	})
+	assert.Equal(t, failure, err)
+}
@@ -0,0 +1,394 @@
+package goentsoe
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"time"
+	"unicode/utf8"
+)
+
+// sniffSize is the number of bytes looked at to find the root element of a
+// streamed response.
+const sniffSize = 1024
+
+// StreamTimeSeries decodes the document read from r one TimeSeries element at
+// a time and passes each converted series to fn, so that memory use does not
+// grow with the size of the document. Decoding stops at the first error
+// returned by fn. Generation and load, publication, balancing and
+// transmission network documents can be streamed; flow based parameters are
+// streamed with StreamFlowBasedParameters. An acknowledgement document is
+// returned as *APIError, matching ErrNoMatchingData if there is no data.
+// Zip archives cannot be streamed and have to be read with Decode.
+func StreamTimeSeries(r io.Reader, fn func(TimeSeries) error) error {
+	return streamDocument(r, func(d *xml.Decoder, start *xml.StartElement, doc Document) error {
+		series, err := decodeTimeSeries(d, start, doc)
+		if err != nil {
+			return err
+		}
+		for _, ts := range series {
+			if err := fn(ts); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// StreamFlowBasedParameters is StreamTimeSeries for critical network element
+// documents, passing the flow based parameters of every market time unit to
+// fn.
+func StreamFlowBasedParameters(r io.Reader, fn func(FlowBasedParameters) error) error {
+	return streamDocument(r, func(d *xml.Decoder, start *xml.StartElement, doc Document) error {
+		cne, ok := doc.(*CriticalNetworkElementMarketDocument)
+		if !ok {
+			return fmt.Errorf("entsoe: cannot stream flow based parameters of %T", doc)
+		}
+		cne.TimeSeries = nil
+		if err := d.DecodeElement(&cne.TimeSeries, start); err != nil {
+			return err
+		}
+		params, err := ConvertCriticalNetworkElementMarketDocument(cne)
+		if err != nil {
+			return err
+		}
+		for _, p := range params {
+			if err := fn(p); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// streamDocument decodes the header of the document read from r and calls
+// element for every TimeSeries element with the start of the element and the
+// document holding the header.
+func streamDocument(r io.Reader, element func(d *xml.Decoder, start *xml.StartElement, doc Document) error) error {
+	br := bufio.NewReader(r)
+	if head, _ := br.Peek(4); isZipArchive(head) {
+		return errors.New("entsoe: cannot stream a zip archive, use Decode")
+	}
+	d := xml.NewDecoder(&latin1Reader{r: br})
+	root, err := nextStartElement(d)
+	if err != nil {
+		return err
+	}
+	var header bytes.Buffer
+	enc := xml.NewEncoder(&header)
+	if root.Name.Local == "Acknowledgement_MarketDocument" {
+		if err := copyElement(enc, d, root); err != nil {
+			return err
+		}
+		if err := enc.Flush(); err != nil {
+			return err
+		}
+		return newAPIError(http.StatusOK, header.Bytes())
+	}
+	doc := newDocument(root.Name.Local)
+	if doc == nil {
+		return fmt.Errorf("entsoe: unknown document type %q", root.Name.Local)
+	}
+
+	// The elements preceding the first TimeSeries make up the header of the
+	// document. They are collected and unmarshalled into doc, which is then
+	// reused for every series.
+	root = withoutNamespace(root)
+	if err := enc.EncodeToken(root); err != nil {
+		return err
+	}
+	headerDone := false
+	for {
+		token, err := d.Token()
+		if err == io.EOF {
+			return io.ErrUnexpectedEOF
+		}
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			if t.Name.Local != "TimeSeries" {
+				if headerDone {
+					err = d.Skip()
+				} else {
+					err = copyElement(enc, d, t)
+				}
+				if err != nil {
+					return err
+				}
+				continue
+			}
+			if !headerDone {
+				if err := enc.EncodeToken(root.End()); err != nil {
+					return err
+				}
+				if err := enc.Flush(); err != nil {
+					return err
+				}
+				if err := xml.Unmarshal(header.Bytes(), doc); err != nil {
+					return err
+				}
+				headerDone = true
+			}
+			if err := element(d, &t, doc); err != nil {
+				return err
+			}
+		case xml.EndElement:
+			return nil
+		}
+	}
+}
+
+// latin1Reader is the streaming counterpart of latin1ToUTF8: it passes valid
+// UTF-8 through and reads every other byte as a Latin-1 character.
+type latin1Reader struct {
+	r       *bufio.Reader
+	pending []byte
+}
+
+func (l *latin1Reader) Read(p []byte) (int, error) {
+	n := 0
+	for n < len(p) {
+		if len(l.pending) > 0 {
+			c := copy(p[n:], l.pending)
+			l.pending = l.pending[c:]
+			n += c
+			continue
+		}
+		// Do not block for more input once something can be returned.
+		if n > 0 && l.r.Buffered() == 0 {
+			break
+		}
+		r, size, err := l.r.ReadRune()
+		if err != nil {
+			if n > 0 {
+				return n, nil
+			}
+			return 0, err
+		}
+		if r == utf8.RuneError && size == 1 {
+			l.r.UnreadRune()
+			b, _ := l.r.ReadByte()
+			r = rune(b)
+		}
+		var buf [utf8.UTFMax]byte
+		l.pending = append(l.pending[:0], buf[:utf8.EncodeRune(buf[:], r)]...)
+	}
+	return n, nil
+}
+
+// nextStartElement returns the next start element of d.
+func nextStartElement(d *xml.Decoder) (xml.StartElement, error) {
+	for {
+		token, err := d.Token()
+		if err != nil {
+			return xml.StartElement{}, err
+		}
+		if se, ok := token.(xml.StartElement); ok {
+			return se, nil
+		}
+	}
+}
+
+// copyElement encodes the element started by start and read from d to enc.
+// Namespaces are dropped as the document types do not use them.
+func copyElement(enc *xml.Encoder, d *xml.Decoder, start xml.StartElement) error {
+	if err := enc.EncodeToken(withoutNamespace(start)); err != nil {
+		return err
+	}
+	for depth := 1; depth > 0; {
+		token, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			depth++
+			token = withoutNamespace(t)
+		case xml.EndElement:
+			depth--
+			token = xml.EndElement{Name: xml.Name{Local: t.Name.Local}}
+		case xml.CharData:
+			token = t.Copy()
+		default:
+			// Comments, processing instructions and directives carry no data.
+			continue
+		}
+		if err := enc.EncodeToken(token); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// withoutNamespace returns a copy of start without namespace and namespace
+// declarations.
+func withoutNamespace(start xml.StartElement) xml.StartElement {
+	res := xml.StartElement{Name: xml.Name{Local: start.Name.Local}}
+	for _, attr := range start.Attr {
+		if attr.Name.Space == "xmlns" || attr.Name.Local == "xmlns" {
+			continue
+		}
+		res.Attr = append(res.Attr, xml.Attr{Name: xml.Name{Local: attr.Name.Local}, Value: attr.Value})
+	}
+	return res
+}
+
+// decodeTimeSeries decodes the TimeSeries element started by start into doc,
+// replacing the series decoded before, and converts it.
+func decodeTimeSeries(d *xml.Decoder, start *xml.StartElement, doc Document) ([]TimeSeries, error) {
+	switch doc := doc.(type) {
+	case *GLMarketDocument:
+		doc.TimeSeries = nil
+		if err := d.DecodeElement(&doc.TimeSeries, start); err != nil {
+			return nil, err
+		}
+		return ConvertGLMarketDocument(doc)
+	case *PublicationMarketDocument:
+		doc.TimeSeries = nil
+		if err := d.DecodeElement(&doc.TimeSeries, start); err != nil {
+			return nil, err
+		}
+		return ConvertPublicationMarketDocument(doc)
+	case *BalancingMarketDocument:
+		doc.TimeSeries = nil
+		if err := d.DecodeElement(&doc.TimeSeries, start); err != nil {
+			return nil, err
+		}
+		return ConvertBalancingMarketDocument(doc)
+	case *TransmissionNetworkMarketDocument:
+		doc.TimeSeries = nil
+		if err := d.DecodeElement(&doc.TimeSeries, start); err != nil {
+			return nil, err
+		}
+		return ConvertTransmissionNetworkMarketDocument(doc)
+	}
+	return nil, fmt.Errorf("entsoe: cannot stream time series of %T", doc)
+}
+
+// StreamTimeSeries requests the document described by params and passes its
+// time series to fn as they are decoded, see the package level
+// StreamTimeSeries. Periods exceeding the range limit of the document type
+// are requested window by window, one at a time.
+func (c *EntsoeClient) StreamTimeSeries(ctx context.Context, params url.Values, fn func(TimeSeries) error) error {
+	return c.streamWindows(ctx, params, func(r io.Reader) error {
+		return StreamTimeSeries(r, fn)
+	})
+}
+
+// streamWindows requests the windows of params one at a time and passes each
+// response body to stream. Windows without data are tolerated as long as at
+// least one window has data.
+func (c *EntsoeClient) streamWindows(ctx context.Context, params url.Values, stream func(io.Reader) error) error {
+	found := false
+	var noData error
+	for _, window := range c.splitPeriod(params) {
+		err := c.streamWindow(ctx, window, stream)
+		switch {
+		case err == nil:
+			found = true
+		case errors.Is(err, ErrNoMatchingData):
+			noData = err
+		default:
+			return err
+		}
+	}
+	if !found && noData != nil {
+		return noData
+	}
+	return nil
+}
+
+func (c *EntsoeClient) streamWindow(ctx context.Context, params url.Values, stream func(io.Reader) error) error {
+	body, err := c.openStream(ctx, params.Encode())
+	if err != nil {
+		return err
+	}
+	defer body.Close()
+	return stream(body)
+}
+
+// openStream performs the request, retrying it as configured by the retry
+// policy until the response turns out to be a document, and returns the
+// response body. Once the body is returned the request is not retried
+// anymore, as parts of it may have been consumed already.
+func (c *EntsoeClient) openStream(ctx context.Context, paramStr string) (io.ReadCloser, error) {
+	var body io.ReadCloser
+	err := c.retry(ctx, func() (http.Header, error) {
+		resp, err := c.openRequest(ctx, paramStr)
+		if err != nil {
+			return nil, err
+		}
+		r := bufio.NewReaderSize(resp.Body, sniffSize)
+		head, _ := r.Peek(sniffSize)
+		if resp.StatusCode != http.StatusOK || isAcknowledgement(head) {
+			defer resp.Body.Close()
+			bodyBytes, err := ioutil.ReadAll(r)
+			if err != nil {
+				return resp.Header, err
+			}
+			return resp.Header, newAPIError(resp.StatusCode, bodyBytes)
+		}
+		body = struct {
+			io.Reader
+			io.Closer
+		}{r, resp.Body}
+		return nil, nil
+	})
+	return body, err
+}
+
+// StreamActualGenerationOutputPerGenerationUnit is
+// GetActualGenerationOutputPerGenerationUnitCtx for periods too large to be
+// held in memory. The time series are passed to fn one at a time.
+func (c *EntsoeClient) StreamActualGenerationOutputPerGenerationUnit(
+	ctx context.Context,
+	processType ProcessType,
+	inDomain DomainType,
+	periodStart time.Time,
+	periodEnd time.Time,
+	psrType *PsrType,
+	fn func(TimeSeries) error,
+) error {
+	params := url.Values{}
+	params.Add(ParameterDocumentType, string(DocumentTypeActualGeneration))
+	params.Add(ParameterProcessType, string(processType))
+	params.Add(ParameterInDomain, string(inDomain))
+	params.Add(ParameterPeriodStart, periodStart.UTC().Format("200601021504"))
+	params.Add(ParameterPeriodEnd, periodEnd.UTC().Format("200601021504"))
+	if psrType != nil {
+		params.Add(ParameterPsrType, string(*psrType))
+	}
+	return c.StreamTimeSeries(ctx, params, fn)
+}
+
+// StreamFlowBasedParameters is GetFlowBasedParametersCtx for periods too large
+// to be held in memory. The flow based parameters of every market time unit
+// are passed to fn one at a time.
+func (c *EntsoeClient) StreamFlowBasedParameters(
+	ctx context.Context,
+	processType ProcessType,
+	domain DomainType,
+	periodStart time.Time,
+	periodEnd time.Time,
+	fn func(FlowBasedParameters) error,
+) error {
+	params := url.Values{}
+	params.Add(ParameterDocumentType, string(DocumentTypeFlowBasedAllocations))
+	params.Add(ParameterProcessType, string(processType))
+	params.Add(ParameterInDomain, string(domain))
+	params.Add(ParameterOutDomain, string(domain))
+	params.Add(ParameterPeriodStart, periodStart.UTC().Format("200601021504"))
+	params.Add(ParameterPeriodEnd, periodEnd.UTC().Format("200601021504"))
+	return c.streamWindows(ctx, params, func(r io.Reader) error {
+		return StreamFlowBasedParameters(r, fn)
+	})
+}
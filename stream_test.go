@@ -0,0 +1,180 @@
+package goentsoe
+
+import (
+	"context"
+	"encoding/xml"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"testing/iotest"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const imbalancePricesDocument = `<?xml version="1.0" encoding="UTF-8"?>
+<Balancing_MarketDocument xmlns="urn:iec62325.351:tc57wg16:451-6:balancingdocument:3:0">
+	<mRID>623e96582b1a4f98af7032228</mRID>
+	<type>A85</type>
+	<area_Domain.mRID codingScheme="A01">10YCZ-CEPS-----N</area_Domain.mRID>
+	<!-- comments in the header are ignored -->
+	<period.timeInterval>
+		<start>2015-12-31T23:00Z</start>
+		<end>2016-01-01T00:00Z</end>
+	</period.timeInterval>
+	<TimeSeries>
+		<mRID>1</mRID>
+		<businessType>A19</businessType>
+		<currency_Unit.name>EUR</currency_Unit.name>
+		<curveType>A01</curveType>
+		<Period>
+			<timeInterval>
+				<start>2015-12-31T23:00Z</start>
+				<end>2016-01-01T00:00Z</end>
+			</timeInterval>
+			<resolution>PT30M</resolution>
+			<Point><position>1</position><imbalance_Price.amount>41.5</imbalance_Price.amount></Point>
+			<Point><position>2</position><imbalance_Price.amount>38</imbalance_Price.amount></Point>
+		</Period>
+	</TimeSeries>
+	<TimeSeries>
+		<mRID>2</mRID>
+		<businessType>A20</businessType>
+		<currency_Unit.name>EUR</currency_Unit.name>
+		<curveType>A01</curveType>
+		<Period>
+			<timeInterval>
+				<start>2015-12-31T23:00Z</start>
+				<end>2016-01-01T00:00Z</end>
+			</timeInterval>
+			<resolution>PT60M</resolution>
+			<Point><position>1</position><imbalance_Price.amount>12</imbalance_Price.amount></Point>
+		</Period>
+	</TimeSeries>
+</Balancing_MarketDocument>`
+
+func TestStreamTimeSeries(t *testing.T) {
+	var series []TimeSeries
+	err := StreamTimeSeries(strings.NewReader(imbalancePricesDocument), func(ts TimeSeries) error {
+		series = append(series, ts)
+		return nil
+	})
+	assert.Nil(t, err)
+	assert.Len(t, series, 2)
+	assert.Equal(t, DomainCZ, series[0].Domain)
+	assert.Equal(t, "EUR", series[0].Currency)
+	assert.Len(t, series[0].Points, 2)
+	assert.Equal(t, 38.0, series[0].Points[1].Value)
+	assert.Equal(t, "2", series[1].MRID)
+	assert.Equal(t, DomainCZ, series[1].Domain)
+	assert.Len(t, series[1].Points, 1)
+}
+
+func TestStreamTimeSeriesStopsOnError(t *testing.T) {
+	stop := errors.New("stop")
+	calls := 0
+	err := StreamTimeSeries(strings.NewReader(imbalancePricesDocument), func(ts TimeSeries) error {
+		calls++
+		return stop
+	})
+	assert.Equal(t, stop, err)
+	assert.Equal(t, 1, calls)
+}
+
+func TestStreamTimeSeriesRejectsTruncatedDocuments(t *testing.T) {
+	doc := imbalancePricesDocument[:strings.Index(imbalancePricesDocument, "<mRID>2</mRID>")]
+	err := StreamTimeSeries(strings.NewReader(doc), func(ts TimeSeries) error {
+		return nil
+	})
+	assert.NotNil(t, err)
+
+}
+
+func TestStreamTimeSeriesReadsLatin1(t *testing.T) {
+	doc := strings.Replace(imbalancePricesDocument, "<mRID>1</mRID>", "<mRID>Z\u00fcrich</mRID>", 1)
+	doc = strings.Replace(doc, "<mRID>2</mRID>", "<mRID>Z\xfcrich</mRID>", 1)
+	var mrids []string
+	err := StreamTimeSeries(iotest.OneByteReader(strings.NewReader(doc)), func(ts TimeSeries) error {
+		mrids = append(mrids, ts.MRID)
+		return nil
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"Z\u00fcrich", "Z\u00fcrich"}, mrids)
+}
+
+func TestStreamTimeSeriesRejectsZipArchives(t *testing.T) {
+	err := StreamTimeSeries(strings.NewReader("PK\x03\x04"), func(ts TimeSeries) error {
+		return nil
+	})
+	assert.EqualError(t, err, "entsoe: cannot stream a zip archive, use Decode")
+}
+
+func TestStreamTimeSeriesReturnsAcknowledgements(t *testing.T) {
+	err := StreamTimeSeries(strings.NewReader(noMatchingDataAcknowledgement), func(ts TimeSeries) error {
+		return nil
+	})
+	assert.ErrorIs(t, err, ErrNoMatchingData)
+	var apiErr *APIError
+	assert.True(t, errors.As(err, &apiErr))
+}
+
+func TestStreamFlowBasedParameters(t *testing.T) {
+	var doc CriticalNetworkElementMarketDocument
+	assert.Nil(t, xml.Unmarshal([]byte(flowBasedParametersDocument), &doc))
+	expected, err := ConvertCriticalNetworkElementMarketDocument(&doc)
+	assert.Nil(t, err)
+
+	var params []FlowBasedParameters
+	err = StreamFlowBasedParameters(strings.NewReader(flowBasedParametersDocument), func(p FlowBasedParameters) error {
+		params = append(params, p)
+		return nil
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, expected, params)
+
+	err = StreamFlowBasedParameters(strings.NewReader(imbalancePricesDocument), func(p FlowBasedParameters) error {
+		return nil
+	})
+	assert.EqualError(t, err, "entsoe: cannot stream flow based parameters of *goentsoe.BalancingMarketDocument")
+}
+
+func TestClientStreamTimeSeries(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		switch requests {
+		case 1:
+			w.WriteHeader(http.StatusServiceUnavailable)
+		case 2:
+			w.Write([]byte(actualTotalLoadDocument))
+		default:
+			w.Write([]byte(noMatchingDataAcknowledgement))
+		}
+	}))
+	defer server.Close()
+
+	c := NewEntsoeClient(
+		"token",
+		WithBaseURL(server.URL),
+		WithRetryPolicy(RetryPolicy{MaxAttempts: 2, RetryStatus: map[int]bool{http.StatusServiceUnavailable: true}}),
+	)
+	var series []TimeSeries
+	err := c.StreamActualGenerationOutputPerGenerationUnit(
+		context.Background(),
+		ProcessTypeRealised,
+		DomainCZ,
+		time.Date(2015, 12, 31, 23, 0, 0, 0, time.UTC),
+		time.Date(2016, 1, 2, 23, 0, 0, 0, time.UTC),
+		nil,
+		func(ts TimeSeries) error {
+			series = append(series, ts)
+			return nil
+		},
+	)
+	assert.Nil(t, err)
+	assert.Equal(t, 3, requests)
+	assert.Len(t, series, 1)
+	assert.Len(t, series[0].Points, 3)
+}
@@ -0,0 +1,365 @@
+package goentsoe
+
+import (
+	"fmt"
+	"math/big"
+	"strconv"
+	"time"
+)
+
+// TimeSeries is a document type independent view of one TimeSeries element
+// with parsed timestamps and values.
+type TimeSeries struct {
+	MRID         string
+	BusinessType BusinessType
+	PsrType      PsrType
+	// Domain is the area the series refers to: the bidding zone, control
+	// area or area domain of the document, whichever the series carries.
+	Domain DomainType
+	// InDomain and OutDomain are set for series describing flows or
+	// capacities between two areas.
+	InDomain  DomainType
+	OutDomain DomainType
+	// Unit is the measure unit of quantities, e.g. MAW or MWH.
+	Unit string
+	// Currency and PriceUnit are set for series of prices, e.g. EUR per MWH.
+	Currency   string
+	PriceUnit  string
+	CurveType  CurveType
+	Resolution string
+	Points     []Point
+	// Missing holds the start of every position of a fixed block (A01)
+	// series for which the document has no point.
+	Missing []time.Time
+}
+
+// Point is the value of a series over the interval [Start, End).
+type Point struct {
+	Start time.Time
+	End   time.Time
+	Value float64
+	// Text is the value as written in the document. Use Rat to compute with
+	// it without the rounding errors of float64.
+	Text string
+}
+
+// Rat returns the exact decimal value of the point.
+func (p Point) Rat() (*big.Rat, error) {
+	r, ok := new(big.Rat).SetString(p.Text)
+	if !ok {
+		return nil, fmt.Errorf("invalid value %q", p.Text)
+	}
+	return r, nil
+}
+
+// rawPeriod is the Period of a zek-generated TimeSeries reduced to the
+// fields needed to compute its points.
+type rawPeriod struct {
+	curveType  CurveType
+	start      string
+	end        string
+	resolution string
+	// location is the local time of the area of the period, in which
+	// calendar resolutions such as P1D or P1M are counted. Nil means UTC.
+	location *time.Location
+	points   []rawPoint
+}
+
+// rawPoint is a point of a rawPeriod. An empty value marks a point that does
+// not carry the measured quantity.
+type rawPoint struct {
+	position string
+	value    string
+}
+
+// ConvertGLMarketDocument returns the normalized time series of a generation
+// and load document.
+func ConvertGLMarketDocument(doc *GLMarketDocument) ([]TimeSeries, error) {
+	periods := glRawPeriods(doc)
+	res := make([]TimeSeries, 0, len(doc.TimeSeries))
+	for i, ts := range doc.TimeSeries {
+		points, missing, err := periods[i].convert()
+		if err != nil {
+			return nil, fmt.Errorf("time series %s: %w", ts.MRID, err)
+		}
+		domain := DomainType(ts.InBiddingZoneDomainMRID.Text)
+		if domain == "" {
+			domain = DomainType(ts.OutBiddingZoneDomainMRID.Text)
+		}
+		res = append(res, TimeSeries{
+			MRID:         ts.MRID,
+			BusinessType: BusinessType(ts.BusinessType),
+			PsrType:      PsrType(ts.MktPSRType.PsrType),
+			Domain:       domain,
+			InDomain:     DomainType(ts.InBiddingZoneDomainMRID.Text),
+			OutDomain:    DomainType(ts.OutBiddingZoneDomainMRID.Text),
+			Unit:         ts.QuantityMeasureUnitName,
+			CurveType:    CurveType(ts.CurveType),
+			Resolution:   ts.Period.Resolution,
+			Points:       points,
+			Missing:      missing,
+		})
+	}
+	return res, nil
+}
+
+// ConvertPublicationMarketDocument returns the normalized time series of a
+// publication document. Series with a currency hold prices, all others
+// quantities.
+func ConvertPublicationMarketDocument(doc *PublicationMarketDocument) ([]TimeSeries, error) {
+	res := make([]TimeSeries, 0, len(doc.TimeSeries))
+	for _, ts := range doc.TimeSeries {
+		period := rawPeriod{
+			curveType:  CurveType(ts.CurveType),
+			start:      ts.Period.TimeInterval.Start,
+			end:        ts.Period.TimeInterval.End,
+			resolution: ts.Period.Resolution,
+			location:   periodLocation(DomainType(ts.InDomainMRID.Text), DomainType(ts.OutDomainMRID.Text)),
+		}
+		for _, p := range ts.Period.Point {
+			value := p.Quantity
+			if ts.CurrencyUnitName != "" {
+				value = p.PriceAmount
+			}
+			period.points = append(period.points, rawPoint{p.Position, value})
+		}
+		points, missing, err := period.convert()
+		if err != nil {
+			return nil, fmt.Errorf("time series %s: %w", ts.MRID, err)
+		}
+		domain := DomainType(ts.InDomainMRID.Text)
+		if ts.InDomainMRID.Text != ts.OutDomainMRID.Text {
+			domain = ""
+		}
+		res = append(res, TimeSeries{
+			MRID:         ts.MRID,
+			BusinessType: BusinessType(ts.BusinessType),
+			Domain:       domain,
+			InDomain:     DomainType(ts.InDomainMRID.Text),
+			OutDomain:    DomainType(ts.OutDomainMRID.Text),
+			Unit:         ts.QuantityMeasureUnitName,
+			Currency:     ts.CurrencyUnitName,
+			PriceUnit:    ts.PriceMeasureUnitName,
+			CurveType:    CurveType(ts.CurveType),
+			Resolution:   ts.Period.Resolution,
+			Points:       points,
+			Missing:      missing,
+		})
+	}
+	return res, nil
+}
+
+// ConvertBalancingMarketDocument returns the normalized time series of a
+// balancing document. The value of a point is the first of its quantity,
+// imbalance price, activation price and procurement price that is present.
+func ConvertBalancingMarketDocument(doc *BalancingMarketDocument) ([]TimeSeries, error) {
+	domain := DomainType(doc.AreaDomainMRID.Text)
+	if domain == "" {
+		domain = DomainType(doc.ControlAreaDomainMRID.Text)
+	}
+	res := make([]TimeSeries, 0, len(doc.TimeSeries))
+	for _, ts := range doc.TimeSeries {
+		period := rawPeriod{
+			curveType:  CurveType(ts.CurveType),
+			start:      ts.Period.TimeInterval.Start,
+			end:        ts.Period.TimeInterval.End,
+			resolution: ts.Period.Resolution,
+			location:   periodLocation(domain),
+		}
+		for _, p := range ts.Period.Point {
+			value := p.Quantity
+			for _, v := range []string{p.ImbalancePriceAmount, p.ActivationPriceAmount, p.ProcurementPriceAmount} {
+				if value == "" {
+					value = v
+				}
+			}
+			period.points = append(period.points, rawPoint{p.Position, value})
+		}
+		points, missing, err := period.convert()
+		if err != nil {
+			return nil, fmt.Errorf("time series %s: %w", ts.MRID, err)
+		}
+		res = append(res, TimeSeries{
+			MRID:         ts.MRID,
+			BusinessType: BusinessType(ts.BusinessType),
+			PsrType:      PsrType(ts.MktPSRTypePsrType),
+			Domain:       domain,
+			Unit:         ts.QuantityMeasureUnitName,
+			Currency:     ts.CurrencyUnitName,
+			PriceUnit:    ts.PriceMeasureUnitName,
+			CurveType:    CurveType(ts.CurveType),
+			Resolution:   ts.Period.Resolution,
+			Points:       points,
+			Missing:      missing,
+		})
+	}
+	return res, nil
+}
+
+// ConvertTransmissionNetworkMarketDocument returns the normalized time series
+// of a transmission network document. Series with a currency hold congestion
+// costs, all others quantities.
+func ConvertTransmissionNetworkMarketDocument(doc *TransmissionNetworkMarketDocument) ([]TimeSeries, error) {
+	res := make([]TimeSeries, 0, len(doc.TimeSeries))
+	for _, ts := range doc.TimeSeries {
+		period := rawPeriod{
+			curveType:  CurveType(ts.CurveType),
+			start:      ts.Period.TimeInterval.Start,
+			end:        ts.Period.TimeInterval.End,
+			resolution: ts.Period.Resolution,
+			location:   periodLocation(DomainType(ts.InDomainMRID.Text), DomainType(ts.OutDomainMRID.Text)),
+		}
+		for _, p := range ts.Period.Point {
+			value := p.Quantity
+			if ts.CurrencyUnitName != "" {
+				value = p.CongestionCostPriceAmount
+			}
+			period.points = append(period.points, rawPoint{p.Position, value})
+		}
+		points, missing, err := period.convert()
+		if err != nil {
+			return nil, fmt.Errorf("time series %s: %w", ts.MRID, err)
+		}
+		domain := DomainType(ts.InDomainMRID.Text)
+		if ts.InDomainMRID.Text != ts.OutDomainMRID.Text {
+			domain = ""
+		}
+		res = append(res, TimeSeries{
+			MRID:         ts.MRID,
+			BusinessType: BusinessType(ts.BusinessType),
+			Domain:       domain,
+			InDomain:     DomainType(ts.InDomainMRID.Text),
+			OutDomain:    DomainType(ts.OutDomainMRID.Text),
+			Unit:         ts.QuantityMeasureUnitName,
+			Currency:     ts.CurrencyUnitName,
+			CurveType:    CurveType(ts.CurveType),
+			Resolution:   ts.Period.Resolution,
+			Points:       points,
+			Missing:      missing,
+		})
+	}
+	return res, nil
+}
+
+// glRawPeriods returns the period of every time series of doc.
+func glRawPeriods(doc *GLMarketDocument) []rawPeriod {
+	periods := make([]rawPeriod, len(doc.TimeSeries))
+	for i, ts := range doc.TimeSeries {
+		periods[i] = rawPeriod{
+			curveType:  CurveType(ts.CurveType),
+			start:      ts.Period.TimeInterval.Start,
+			end:        ts.Period.TimeInterval.End,
+			resolution: ts.Period.Resolution,
+			location:   periodLocation(DomainType(ts.InBiddingZoneDomainMRID.Text), DomainType(ts.OutBiddingZoneDomainMRID.Text)),
+		}
+		for _, p := range ts.Period.Point {
+			periods[i].points = append(periods[i].points, rawPoint{p.Position, p.Quantity})
+		}
+	}
+	return periods
+}
+
+// periodLocation returns the local time of the first of domains found in the
+// area registry, or UTC if there is none.
+func periodLocation(domains ...DomainType) *time.Location {
+	for _, domain := range domains {
+		if loc, err := AreaLocation(domain); err == nil {
+			return loc
+		}
+	}
+	return time.UTC
+}
+
+// expandedPoint is a rawPoint placed on the time axis.
+type expandedPoint struct {
+	position int
+	start    time.Time
+	end      time.Time
+	value    string
+}
+
+// expand places the points of the period on the time axis according to their
+// position. Variable sized block (A03) series omit positions whose value
+// equals the previous one, so those are filled by carrying the previous value
+// forward up to the end of the period. For all other curve types omitted
+// positions are returned as missing. Points without a value count as
+// omitted.
+func (p *rawPeriod) expand() ([]expandedPoint, []time.Time, error) {
+	start, err := time.Parse("2006-01-02T15:04Z", p.start)
+	if err != nil {
+		return nil, nil, err
+	}
+	if p.location != nil {
+		start = start.In(p.location)
+	}
+	resolution, err := parseResolution(p.resolution)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	values := make(map[int]string, len(p.points))
+	positions := 0
+	for _, rp := range p.points {
+		if rp.value == "" {
+			continue
+		}
+		position, err := strconv.Atoi(rp.position)
+		if err != nil || position < 1 {
+			return nil, nil, fmt.Errorf("invalid position %q", rp.position)
+		}
+		values[position] = rp.value
+		if position > positions {
+			positions = position
+		}
+	}
+	if end, err := time.Parse("2006-01-02T15:04Z", p.end); err == nil {
+		for n := positions; resolution.AddTo(start, n).Before(end); n++ {
+			positions = n + 1
+		}
+	}
+
+	points := make([]expandedPoint, 0, positions)
+	var missing []time.Time
+	previous := ""
+	for position := 1; position <= positions; position++ {
+		value, ok := values[position]
+		if !ok && p.curveType == CurveTypeVariableSizedBlock {
+			value, ok = previous, previous != ""
+		}
+		pointStart := resolution.AddTo(start, position-1).UTC()
+		if !ok {
+			missing = append(missing, pointStart)
+			continue
+		}
+		points = append(points, expandedPoint{
+			position: position,
+			start:    pointStart,
+			end:      resolution.AddTo(start, position).UTC(),
+			value:    value,
+		})
+		previous = value
+	}
+	return points, missing, nil
+}
+
+// convert computes the points of the period, see expand.
+func (p *rawPeriod) convert() ([]Point, []time.Time, error) {
+	expanded, missing, err := p.expand()
+	if err != nil {
+		return nil, nil, err
+	}
+	points := make([]Point, len(expanded))
+	for i, ep := range expanded {
+		value, err := strconv.ParseFloat(ep.value, 64)
+		if err != nil {
+			return nil, nil, fmt.Errorf("position %d: invalid value %q: %w", ep.position, ep.value, err)
+		}
+		points[i] = Point{
+			Start: ep.start,
+			End:   ep.end,
+			Value: value,
+			Text:  ep.value,
+		}
+	}
+	return points, missing, nil
+}
@@ -0,0 +1,205 @@
+package goentsoe
+
+import (
+	"encoding/xml"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const actualTotalLoadDocument = `<?xml version="1.0" encoding="UTF-8"?>
+<GL_MarketDocument xmlns="urn:iec62325.351:tc57wg16:451-6:generationloaddocument:3:0">
+	<mRID>ed7acd8a6d784b7ab2a703950</mRID>
+	<type>A65</type>
+	<TimeSeries>
+		<mRID>1</mRID>
+		<businessType>A04</businessType>
+		<objectAggregation>A01</objectAggregation>
+		<outBiddingZone_Domain.mRID codingScheme="A01">10YCZ-CEPS-----N</outBiddingZone_Domain.mRID>
+		<quantity_Measure_Unit.name>MAW</quantity_Measure_Unit.name>
+		<curveType>A01</curveType>
+		<Period>
+			<timeInterval>
+				<start>2015-12-31T23:00Z</start>
+				<end>2016-01-01T02:00Z</end>
+			</timeInterval>
+			<resolution>PT60M</resolution>
+			<Point><position>1</position><quantity>5872</quantity></Point>
+			<Point><position>2</position><quantity>5784.5</quantity></Point>
+			<Point><position>3</position><quantity>5690</quantity></Point>
+		</Period>
+	</TimeSeries>
+</GL_MarketDocument>`
+
+const dayAheadPricesDocument = `<?xml version="1.0" encoding="UTF-8"?>
+<Publication_MarketDocument xmlns="urn:iec62325.351:tc57wg16:451-3:publicationdocument:7:0">
+	<mRID>abbbeef260884cb9b43858124</mRID>
+	<type>A44</type>
+	<TimeSeries>
+		<mRID>1</mRID>
+		<businessType>A62</businessType>
+		<in_Domain.mRID codingScheme="A01">10YCZ-CEPS-----N</in_Domain.mRID>
+		<out_Domain.mRID codingScheme="A01">10YCZ-CEPS-----N</out_Domain.mRID>
+		<currency_Unit.name>EUR</currency_Unit.name>
+		<price_Measure_Unit.name>MWH</price_Measure_Unit.name>
+		<curveType>A01</curveType>
+		<Period>
+			<timeInterval>
+				<start>2015-12-31T23:00Z</start>
+				<end>2016-01-01T01:00Z</end>
+			</timeInterval>
+			<resolution>PT60M</resolution>
+			<Point><position>1</position><price.amount>16.50</price.amount></Point>
+			<Point><position>2</position><price.amount>-1.25</price.amount></Point>
+		</Period>
+	</TimeSeries>
+</Publication_MarketDocument>`
+
+func TestConvertGLMarketDocument(t *testing.T) {
+	var doc GLMarketDocument
+	assert.Nil(t, xml.Unmarshal([]byte(actualTotalLoadDocument), &doc))
+
+	series, err := ConvertGLMarketDocument(&doc)
+	assert.Nil(t, err)
+	assert.Len(t, series, 1)
+	ts := series[0]
+	assert.Equal(t, DomainCZ, ts.Domain)
+	assert.Equal(t, "MAW", ts.Unit)
+	assert.Equal(t, CurveTypeSequentialFixedSizeBlock, ts.CurveType)
+	assert.Len(t, ts.Points, 3)
+	assert.Equal(t, Point{
+		Start: time.Date(2016, 1, 1, 0, 0, 0, 0, time.UTC),
+		End:   time.Date(2016, 1, 1, 1, 0, 0, 0, time.UTC),
+		Value: 5784.5,
+		Text:  "5784.5",
+	}, ts.Points[1])
+}
+
+func TestConvertPublicationMarketDocument(t *testing.T) {
+	var doc PublicationMarketDocument
+	assert.Nil(t, xml.Unmarshal([]byte(dayAheadPricesDocument), &doc))
+
+	series, err := ConvertPublicationMarketDocument(&doc)
+	assert.Nil(t, err)
+	assert.Len(t, series, 1)
+	ts := series[0]
+	assert.Equal(t, DomainCZ, ts.Domain)
+	assert.Equal(t, "EUR", ts.Currency)
+	assert.Equal(t, "MWH", ts.PriceUnit)
+	assert.Equal(t, []float64{16.5, -1.25}, []float64{ts.Points[0].Value, ts.Points[1].Value})
+}
+
+func TestConvertRejectsMalformedValues(t *testing.T) {
+	var doc GLMarketDocument
+	assert.Nil(t, xml.Unmarshal([]byte(actualTotalLoadDocument), &doc))
+	doc.TimeSeries[0].Period.Point[2].Quantity = "n/a"
+
+	_, err := ConvertGLMarketDocument(&doc)
+	assert.EqualError(t, err, `time series 1: position 3: invalid value "n/a": strconv.ParseFloat: parsing "n/a": invalid syntax`)
+}
+
+func TestConvertExpandsVariableSizedBlocks(t *testing.T) {
+	var doc GLMarketDocument
+	assert.Nil(t, xml.Unmarshal([]byte(actualTotalLoadDocument), &doc))
+	doc.TimeSeries[0].CurveType = string(CurveTypeVariableSizedBlock)
+	doc.TimeSeries[0].Period.Point = doc.TimeSeries[0].Period.Point[:2]
+	doc.TimeSeries[0].Period.Point[1].Position = "3"
+
+	series, err := ConvertGLMarketDocument(&doc)
+	assert.Nil(t, err)
+	ts := series[0]
+	assert.Empty(t, ts.Missing)
+	assert.Len(t, ts.Points, 3)
+	assert.Equal(t, []float64{5872, 5872, 5784.5}, []float64{ts.Points[0].Value, ts.Points[1].Value, ts.Points[2].Value})
+	assert.Equal(t, time.Date(2016, 1, 1, 1, 0, 0, 0, time.UTC), ts.Points[2].Start)
+
+	// The last block extends to the end of the period.
+	doc.TimeSeries[0].Period.Point = doc.TimeSeries[0].Period.Point[:1]
+	series, err = ConvertGLMarketDocument(&doc)
+	assert.Nil(t, err)
+	assert.Len(t, series[0].Points, 3)
+	assert.Equal(t, 5872.0, series[0].Points[2].Value)
+}
+
+func TestConvertReportsMissingFixedSizeBlocks(t *testing.T) {
+	var doc GLMarketDocument
+	assert.Nil(t, xml.Unmarshal([]byte(actualTotalLoadDocument), &doc))
+	doc.TimeSeries[0].Period.Point = doc.TimeSeries[0].Period.Point[:2]
+	doc.TimeSeries[0].Period.Point[1].Position = "3"
+
+	series, err := ConvertGLMarketDocument(&doc)
+	assert.Nil(t, err)
+	ts := series[0]
+	assert.Len(t, ts.Points, 2)
+	assert.Equal(t, time.Date(2016, 1, 1, 1, 0, 0, 0, time.UTC), ts.Points[1].Start)
+	assert.Equal(t, []time.Time{
+		time.Date(2016, 1, 1, 0, 0, 0, 0, time.UTC),
+	}, ts.Missing)
+
+	res, err := ConvertGlMarketDocument2Map(&doc)
+	assert.Nil(t, err)
+	assert.Equal(t, []time.Time{
+		time.Date(2015, 12, 31, 23, 0, 0, 0, time.UTC),
+		time.Date(2016, 1, 1, 1, 0, 0, 0, time.UTC),
+	}, GetSortedTimes(res))
+}
+
+func TestConvertCountsCalendarResolutionsInLocalTime(t *testing.T) {
+	var doc GLMarketDocument
+	assert.Nil(t, xml.Unmarshal([]byte(actualTotalLoadDocument), &doc))
+	doc.TimeSeries[0].Period.Resolution = "P1M"
+	doc.TimeSeries[0].Period.TimeInterval.End = "2016-03-31T22:00Z"
+
+	series, err := ConvertGLMarketDocument(&doc)
+	assert.Nil(t, err)
+	ts := series[0]
+	assert.Len(t, ts.Points, 3)
+	assert.Equal(t, time.Date(2016, 1, 31, 23, 0, 0, 0, time.UTC), ts.Points[1].Start)
+	assert.Equal(t, time.Date(2016, 2, 29, 23, 0, 0, 0, time.UTC), ts.Points[2].Start)
+	// March ends in summer time.
+	assert.Equal(t, time.Date(2016, 3, 31, 22, 0, 0, 0, time.UTC), ts.Points[2].End)
+}
+
+func TestPointRat(t *testing.T) {
+	var sum big.Rat
+	for _, text := range []string{"78.39", "0.01", "-0.4"} {
+		r, err := Point{Text: text}.Rat()
+		assert.Nil(t, err)
+		sum.Add(&sum, r)
+	}
+	assert.Equal(t, "78.00", sum.FloatString(2))
+
+	_, err := Point{Text: "n/a"}.Rat()
+	assert.EqualError(t, err, `invalid value "n/a"`)
+}
+
+func TestConvertGlMarketDocument2MapRoundsQuantities(t *testing.T) {
+	var doc GLMarketDocument
+	assert.Nil(t, xml.Unmarshal([]byte(actualTotalLoadDocument), &doc))
+	doc.TimeSeries[0].Period.Point[0].Quantity = "78.39"
+
+	res, err := ConvertGlMarketDocument2Map(&doc)
+	assert.Nil(t, err)
+	assert.Equal(t, 78, res[time.Date(2015, 12, 31, 23, 0, 0, 0, time.UTC)])
+	assert.Equal(t, 5785, res[time.Date(2016, 1, 1, 0, 0, 0, 0, time.UTC)])
+
+	res = make(map[time.Time]int)
+	assert.Nil(t, PopulateMap(&doc, false, res))
+	assert.Equal(t, 78, res[time.Date(2015, 12, 31, 23, 0, 0, 0, time.UTC)])
+}
+
+func TestConvertGlMarketDocument2MapReturnsErrors(t *testing.T) {
+	var doc GLMarketDocument
+	assert.Nil(t, xml.Unmarshal([]byte(actualTotalLoadDocument), &doc))
+	doc.TimeSeries[0].Period.Point[1].Quantity = "n/a"
+
+	_, err := ConvertGlMarketDocument2Map(&doc)
+	assert.EqualError(t, err, `time series 1: invalid quantity "n/a": strconv.ParseFloat: parsing "n/a": invalid syntax`)
+	assert.NotNil(t, PopulateMap(&doc, false, make(map[time.Time]int)))
+
+	doc.TimeSeries[0].Period.TimeInterval.Start = "2015-12-31"
+	_, err = ConvertGlMarketDocument2Map(&doc)
+	assert.NotNil(t, err)
+}
@@ -11,6 +11,7 @@ import (
 	"path/filepath"
 	"regexp"
 	"sort"
+	"unicode/utf8"
 
 	"github.com/sirupsen/logrus"
 )
@@ -100,6 +101,14 @@ var sampleRequests = map[string]string{
 	// 4.3.3. Costs of Congestion Management [13.1.C]
 	"4.3.3.": "documentType=A92&businessType=B03&in_Domain=10YCZ-CEPS-----N&out_Domain=10YCZ-CEPS-----N&periodStart=201512312300&periodEnd=201612312300",
 
+	// 4.3.1. Redispatching [13.1.A], internal redispatching with several
+	// points per period, so that Point is generated as a slice
+	"4.3.1.internal": "documentType=A63&businessType=A85&in_Domain=10Y1001A1001A82H&out_Domain=10Y1001A1001A82H&periodStart=202301012300&periodEnd=202301082300",
+
+	// 4.3.3. Costs of Congestion Management [13.1.C], several months per
+	// period with quantities and costs
+	"4.3.3.germany": "documentType=A92&in_Domain=10Y1001A1001A82H&out_Domain=10Y1001A1001A82H&periodStart=202212312300&periodEnd=202312312300",
+
 	// 4.4.1. Installed Generation Capacity Aggregated [14.1.A]
 	"4.4.1.": "documentType=A68&processType=A33&psrType=B16&in_Domain=10YCZ-CEPS-----N&periodStart=201512312300&periodEnd=201612312300",
 
@@ -128,8 +137,7 @@ var sampleRequests = map[string]string{
 	"4.4.9.": "documentType=A72&processType=A16&in_Domain=10YCZ-CEPS-----N&periodStart=201512312300&periodEnd=201612312300",
 
 	// 4.5.1. Production and Generation Units
-	// problem with illegal UTF-8 encoding if examples are created by zek
-	//"4.5.1.": "documentType=A95&businessType=B11&biddingZone_Domain=10YCZ-CEPS-----N&implementation_DateAndOrTime=2017-01-01",
+	"4.5.1.": "documentType=A95&businessType=B11&biddingZone_Domain=10YCZ-CEPS-----N&implementation_DateAndOrTime=2017-01-01",
 
 	// 4.6.1. Current Balancing State [GL EB 12.3.A]
 	"4.6.1.": "documentType=A86&businessType=B33&Area_Domain=10YCZ-CEPS-----N&periodStart=201912190000&periodEnd=201912190010",
@@ -200,8 +208,19 @@ var sampleRequests = map[string]string{
 	// 4.7.4. Unavailability of Generation Units [15.1.A&B]
 	"4.7.4.": "documentType=A80&businessType=A53&biddingZone_Domain=10YCZ-CEPS-----N&periodStart=201512312300&periodEnd=201612312300",
 
+	// 4.7.4. Unavailability of Generation Units [15.1.A&B], forced outages with
+	// reduced capacity, which carry Available_Period
+	"4.7.4.forced": "documentType=A80&businessType=A54&biddingZone_Domain=10YCZ-CEPS-----N&periodStart=201512312300&periodEnd=201612312300",
+
+	// 4.7.4. Unavailability of Generation Units [15.1.A&B], withdrawn outages,
+	// which carry docStatus
+	"4.7.4.withdrawn": "documentType=A80&businessType=A53&docStatus=A13&biddingZone_Domain=10YCZ-CEPS-----N&periodStart=201512312300&periodEnd=201612312300",
+
 	// 4.7.5. Unavailability of Production Units [15.1.C&D]
 	"4.7.5.": "documentType=A77&businessType=A53&biddingZone_Domain=10YCZ-CEPS-----N&periodStart=201512312300&periodEnd=201612312300",
+
+	// 4.7.5. Unavailability of Production Units [15.1.C&D], forced outages
+	"4.7.5.forced": "documentType=A77&businessType=A54&biddingZone_Domain=10YCZ-CEPS-----N&periodStart=201512312300&periodEnd=201612312300",
 }
 var log = logrus.New()
 
@@ -312,6 +331,9 @@ func readZipFile(zf *zip.File) ([]byte, error) {
 }
 
 func processFileContent(fileName string, content []byte) string {
+	// Some documents, such as the production and generation units, are
+	// declared as UTF-8 but contain names in Latin-1, which zek rejects.
+	content = latin1ToUTF8(content)
 	matches := re.FindStringSubmatch(string(content))
 	if matches == nil || len(matches) != 2 {
 		fmt.Println(string(content))
@@ -326,3 +348,22 @@ func processFileContent(fileName string, content []byte) string {
 	}
 	return documentType
 }
+
+// latin1ToUTF8 converts the bytes of data that are not valid UTF-8 from
+// Latin-1, like the decoder of the package does.
+func latin1ToUTF8(data []byte) []byte {
+	if utf8.Valid(data) {
+		return data
+	}
+	res := make([]byte, 0, len(data)+len(data)/8)
+	for len(data) > 0 {
+		r, size := utf8.DecodeRune(data)
+		if r == utf8.RuneError && size == 1 {
+			res = append(res, string(rune(data[0]))...)
+		} else {
+			res = append(res, data[:size]...)
+		}
+		data = data[size:]
+	}
+	return res
+}
@@ -0,0 +1,153 @@
+// Command genareas generates the area registry of the goentsoe package from
+// areas.csv. Run it with go generate in the repository root.
+package main
+
+import (
+	"bytes"
+	"encoding/csv"
+	"flag"
+	"fmt"
+	"go/format"
+	"os"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+var kinds = map[string]string{
+	"BZ":  "AreaKindBiddingZone",
+	"CA":  "AreaKindControlArea",
+	"CTY": "AreaKindCountry",
+	"MBA": "AreaKindMarketBalanceArea",
+}
+
+type area struct {
+	Code      string
+	ShortName string
+	Name      string
+	Kind      string
+	TimeZone  string
+	ValidFrom string
+	ValidTo   string
+}
+
+var tmpl = template.Must(template.New("areas").Parse(`// Code generated by go run ./tools/genareas; DO NOT EDIT.
+
+package goentsoe
+
+import "time"
+
+var areas = []Area{
+{{- range .}}
+	{
+		Code:      {{printf "%q" .Code}},
+		ShortName: {{printf "%q" .ShortName}},
+		Name:      {{printf "%q" .Name}},
+		{{- if .Kind}}
+		Kind:      {{.Kind}},
+		{{- end}}
+		TimeZone:  {{printf "%q" .TimeZone}},
+		{{- if .ValidFrom}}
+		ValidFrom: {{.ValidFrom}},
+		{{- end}}
+		{{- if .ValidTo}}
+		ValidTo:   {{.ValidTo}},
+		{{- end}}
+	},
+{{- end}}
+}
+`))
+
+func main() {
+	in := flag.String("in", "tools/genareas/areas.csv", "CSV file with the areas")
+	out := flag.String("out", "areas_gen.go", "generated Go file")
+	flag.Parse()
+
+	areas, err := readAreas(*in)
+	if err != nil {
+		logrus.Fatal(err)
+	}
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, areas); err != nil {
+		logrus.Fatal(err)
+	}
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		logrus.Fatal(err)
+	}
+	if err := os.WriteFile(*out, src, 0644); err != nil {
+		logrus.Fatal(err)
+	}
+}
+
+func readAreas(name string) ([]area, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	records, err := csv.NewReader(f).ReadAll()
+	if err != nil {
+		return nil, err
+	}
+
+	codes := make(map[string]bool)
+	shortNames := make(map[string]bool)
+	var res []area
+	for i, record := range records[1:] {
+		line := i + 2
+		if len(record) != 7 {
+			return nil, fmt.Errorf("line %d: want 7 fields, got %d", line, len(record))
+		}
+		a := area{
+			Code:      record[0],
+			ShortName: record[1],
+			Name:      record[2],
+			TimeZone:  record[4],
+		}
+		if codes[a.Code] || shortNames[a.ShortName] {
+			return nil, fmt.Errorf("line %d: duplicate area %s %s", line, a.Code, a.ShortName)
+		}
+		codes[a.Code] = true
+		shortNames[a.ShortName] = true
+
+		if record[3] != "" {
+			var names []string
+			for _, kind := range strings.Split(record[3], "|") {
+				name, ok := kinds[kind]
+				if !ok {
+					return nil, fmt.Errorf("line %d: unknown area kind %q", line, kind)
+				}
+				names = append(names, name)
+			}
+			a.Kind = strings.Join(names, " | ")
+		}
+		if _, err := time.LoadLocation(a.TimeZone); err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+		if a.ValidFrom, err = timeLiteral(record[5]); err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+		if a.ValidTo, err = timeLiteral(record[6]); err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+		res = append(res, a)
+	}
+	return res, nil
+}
+
+// timeLiteral returns the Go expression of the UTC time s, or an empty string
+// if s is empty.
+func timeLiteral(s string) (string, error) {
+	if s == "" {
+		return "", nil
+	}
+	t, err := time.Parse("2006-01-02T15:04Z07:00", s)
+	if err != nil {
+		return "", err
+	}
+	t = t.UTC()
+	return fmt.Sprintf("time.Date(%d, %d, %d, %d, %d, 0, 0, time.UTC)", t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute()), nil
+}
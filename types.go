@@ -109,12 +109,32 @@ type UnavailabilityMarketDocument struct {
 			Text         string `xml:",chardata"` // 10YDE-EON------1, 10YDE-E...
 			CodingScheme string `xml:"codingScheme,attr"`
 		} `xml:"biddingZone_Domain.mRID"`
-		StartDateAndOrTimeDate                                          string `xml:"start_DateAndOrTime.date"`   // 2015-11-23, 2015-12-29, 2...
-		StartDateAndOrTimeTime                                          string `xml:"start_DateAndOrTime.time"`   // 17:50:00Z, 19:43:00Z, 07:...
-		EndDateAndOrTimeDate                                            string `xml:"end_DateAndOrTime.date"`     // 2016-05-12, 2016-01-05, 2...
-		EndDateAndOrTimeTime                                            string `xml:"end_DateAndOrTime.time"`     // 19:51:00Z, 19:43:00Z, 16:...
-		QuantityMeasureUnitName                                         string `xml:"quantity_Measure_Unit.name"` // MAW, MAW, MAW, MAW, MAW, ...
-		CurveType                                                       string `xml:"curveType"`                  // A03, A03, A03, A03, A03, ...
+		InDomainMRID struct {
+			Text         string `xml:",chardata"` // 10YCZ-CEPS-----N, 10YCZ-C...
+			CodingScheme string `xml:"codingScheme,attr"`
+		} `xml:"in_Domain.mRID"`
+		OutDomainMRID struct {
+			Text         string `xml:",chardata"` // 10YSK-SEPS-----K, 10YSK-S...
+			CodingScheme string `xml:"codingScheme,attr"`
+		} `xml:"out_Domain.mRID"`
+		StartDateAndOrTimeDate           string `xml:"start_DateAndOrTime.date"`   // 2015-11-23, 2015-12-29, 2...
+		StartDateAndOrTimeTime           string `xml:"start_DateAndOrTime.time"`   // 17:50:00Z, 19:43:00Z, 07:...
+		EndDateAndOrTimeDate             string `xml:"end_DateAndOrTime.date"`     // 2016-05-12, 2016-01-05, 2...
+		EndDateAndOrTimeTime             string `xml:"end_DateAndOrTime.time"`     // 19:51:00Z, 19:43:00Z, 16:...
+		QuantityMeasureUnitName          string `xml:"quantity_Measure_Unit.name"` // MAW, MAW, MAW, MAW, MAW, ...
+		CurveType                        string `xml:"curveType"`                  // A03, A03, A03, A03, A03, ...
+		ProductionRegisteredResourceMRID struct {
+			Text         string `xml:",chardata"` // 24WT-PAS-00001-1, 24WT-PA...
+			CodingScheme string `xml:"codingScheme,attr"`
+		} `xml:"production_RegisteredResource.mRID"`
+		ProductionRegisteredResourceName                            string `xml:"production_RegisteredResource.name"`            // PAS1, PAS2, PRU1, ...
+		ProductionRegisteredResourceLocationName                    string `xml:"production_RegisteredResource.location.name"`   // Prunerov, Pocerady, ...
+		ProductionRegisteredResourcePSRTypePsrType                  string `xml:"production_RegisteredResource.pSRType.psrType"` // B02, B02, B14, ...
+		ProductionRegisteredResourcePSRTypePowerSystemResourcesMRID struct {
+			Text         string `xml:",chardata"` // 24WT-PAS-00001-1, 24WT-PA...
+			CodingScheme string `xml:"codingScheme,attr"`
+		} `xml:"production_RegisteredResource.pSRType.powerSystemResources.mRID"`
+		ProductionRegisteredResourcePSRTypePowerSystemResourcesName     string `xml:"production_RegisteredResource.pSRType.powerSystemResources.name"` // PAS B1, PAS B2, ...
 		ProductionRegisteredResourcePSRTypePowerSystemResourcesNominalP struct {
 			Text string `xml:",chardata"` // 113, 156, 864, 144, 144, ...
 			Unit string `xml:"unit,attr"`
@@ -143,11 +163,29 @@ type UnavailabilityMarketDocument struct {
 				Quantity string `xml:"quantity"` // 0, 80, 545, 142, 141, 141...
 			} `xml:"Point"`
 		} `xml:"WindPowerFeedin_Period"`
+		AvailablePeriod []struct {
+			Text         string `xml:",chardata"`
+			TimeInterval struct {
+				Text  string `xml:",chardata"`
+				Start string `xml:"start"` // 2015-12-29T19:43Z, 2016-0...
+				End   string `xml:"end"`   // 2016-01-05T19:43Z, 2016-0...
+			} `xml:"timeInterval"`
+			Resolution string `xml:"resolution"` // PT60M, PT1M, PT1M, PT1M, ...
+			Point      []struct {
+				Text     string `xml:",chardata"`
+				Position string `xml:"position"` // 1, 1, 1, 1, 1, 1, 1, 1, 1...
+				Quantity string `xml:"quantity"` // 0, 80, 545, 142, 141, 141...
+			} `xml:"Point"`
+		} `xml:"Available_Period"`
 	} `xml:"TimeSeries"`
 	Reason struct {
 		Text string `xml:",chardata"`
 		Code string `xml:"code"` // B18, B18, B18, B18, B18, ...
 	} `xml:"Reason"`
+	DocStatus struct {
+		Text  string `xml:",chardata"`
+		Value string `xml:"value"` // A05, A09, A05
+	} `xml:"docStatus"`
 }
 
 type GLMarketDocument struct {
@@ -406,8 +444,10 @@ type TransmissionNetworkMarketDocument struct {
 			Text         string `xml:",chardata"` // 10YCZ-CEPS-----N, 10YCZ-C...
 			CodingScheme string `xml:"codingScheme,attr"`
 		} `xml:"out_Domain.mRID"`
-		CurveType string `xml:"curveType"` // A01, A01, A01, A01, A01, ...
-		Period    struct {
+		QuantityMeasureUnitName string `xml:"quantity_Measure_Unit.name"` // MAW, MAW, MAW, MAW, MAW, ...
+		CurrencyUnitName        string `xml:"currency_Unit.name"`         // EUR, EUR, EUR, EUR, EUR, ...
+		CurveType               string `xml:"curveType"`                  // A01, A01, A01, A01, A01, ...
+		Period                  struct {
 			Text         string `xml:",chardata"`
 			TimeInterval struct {
 				Text  string `xml:",chardata"`
@@ -415,10 +455,89 @@ type TransmissionNetworkMarketDocument struct {
 				End   string `xml:"end"`   // 2016-02-01T00:00Z, 2016-0...
 			} `xml:"timeInterval"`
 			Resolution string `xml:"resolution"` // P1M, P1M, P1M, P1M, P1M, ...
-			Point      struct {
-				Text     string `xml:",chardata"`
-				Position string `xml:"position"` // 1, 1, 1, 1, 1, 1, 1, 1, 1...
+			Point      []struct {
+				Text                      string `xml:",chardata"`
+				Position                  string `xml:"position"`                    // 1, 1, 1, 1, 1, 1, 1, 1, 1...
+				Quantity                  string `xml:"quantity"`                    // 146, 45, 203, 12, 80, 7...
+				CongestionCostPriceAmount string `xml:"congestionCost_Price.amount"` // 4217.95, 0, 12085.6, 0, ...
 			} `xml:"Point"`
 		} `xml:"Period"`
 	} `xml:"TimeSeries"`
 }
+
+type ConfigurationMarketDocument struct {
+	XMLName                     xml.Name `xml:"Configuration_MarketDocument"`
+	Text                        string   `xml:",chardata"`
+	Xmlns                       string   `xml:"xmlns,attr"`
+	MRID                        string   `xml:"mRID"`                // 0c5cb1c1e0d04a4b8ea7b0e38...
+	RevisionNumber              string   `xml:"revisionNumber"`      // 1
+	Type                        string   `xml:"type"`                // A95
+	ProcessProcessType          string   `xml:"process.processType"` // A39
+	SenderMarketParticipantMRID struct {
+		Text         string `xml:",chardata"` // 10X1001A1001A450
+		CodingScheme string `xml:"codingScheme,attr"`
+	} `xml:"sender_MarketParticipant.mRID"`
+	SenderMarketParticipantMarketRoleType string `xml:"sender_MarketParticipant.marketRole.type"` // A32
+	ReceiverMarketParticipantMRID         struct {
+		Text         string `xml:",chardata"` // 10X1001A1001A450
+		CodingScheme string `xml:"codingScheme,attr"`
+	} `xml:"receiver_MarketParticipant.mRID"`
+	ReceiverMarketParticipantMarketRoleType string `xml:"receiver_MarketParticipant.marketRole.type"` // A33
+	CreatedDateTime                         string `xml:"createdDateTime"`                            // 2020-09-12T00:13:20Z
+	TimeSeries                              []struct {
+		Text                            string `xml:",chardata"`
+		MRID                            string `xml:"mRID"`                              // 1, 2, 3, 4, 5, 6, 7, 8, 9...
+		BusinessType                    string `xml:"businessType"`                      // B11, B11, B11, B11, B11, ...
+		ImplementationDateAndOrTimeDate string `xml:"implementation_DateAndOrTime.date"` // 2017-01-01, 2017-01-01, ...
+		BiddingZoneDomainMRID           struct {
+			Text         string `xml:",chardata"` // 10YCZ-CEPS-----N, 10YCZ-C...
+			CodingScheme string `xml:"codingScheme,attr"`
+		} `xml:"biddingZone_Domain.mRID"`
+		RegisteredResourceMRID struct {
+			Text         string `xml:",chardata"` // 27W-PU-EPC1----Y, 27W-PU-...
+			CodingScheme string `xml:"codingScheme,attr"`
+		} `xml:"registeredResource.mRID"`
+		RegisteredResourceName         string `xml:"registeredResource.name"`          // EPC1_______, EME3_______,...
+		RegisteredResourceLocationName string `xml:"registeredResource.location.name"` // Chvaletice, Melnik, Pocerady...
+		ControlAreaDomain              struct {
+			Text string `xml:",chardata"`
+			MRID struct {
+				Text         string `xml:",chardata"` // 10YCZ-CEPS-----N, 10YCZ-C...
+				CodingScheme string `xml:"codingScheme,attr"`
+			} `xml:"mRID"`
+		} `xml:"ControlArea_Domain"`
+		ProviderMarketParticipant struct {
+			Text string `xml:",chardata"`
+			MRID struct {
+				Text         string `xml:",chardata"` // 27XG-SEVEN-ENERG, 27XCEZ--...
+				CodingScheme string `xml:"codingScheme,attr"`
+			} `xml:"mRID"`
+		} `xml:"Provider_MarketParticipant"`
+		MktPSRType struct {
+			Text                                      string `xml:",chardata"`
+			PsrType                                   string `xml:"psrType"` // B02, B02, B14, B10, B05, ...
+			ProductionPowerSystemResourcesHighVoltage struct {
+				Text string `xml:",chardata"` // 400, 400, 110, 220, 400, ...
+				Unit string `xml:"unit,attr"`
+			} `xml:"production_PowerSystemResources.highVoltageLimit"`
+			NominalIPPowerSystemResourcesNominalP struct {
+				Text string `xml:",chardata"` // 820, 800, 2110, 650, 500,...
+				Unit string `xml:"unit,attr"`
+			} `xml:"nominalIP_PowerSystemResources.nominalP"`
+			GeneratingUnitPowerSystemResources []struct {
+				Text string `xml:",chardata"`
+				MRID struct {
+					Text         string `xml:",chardata"` // 27W-GU-ECHVG1--C, 27W-GU-...
+					CodingScheme string `xml:"codingScheme,attr"`
+				} `xml:"mRID"`
+				Name     string `xml:"name"` // ECHV_G1____, ECHV_G2____,...
+				NominalP struct {
+					Text string `xml:",chardata"` // 205, 205, 205, 205, 220, ...
+					Unit string `xml:"unit,attr"`
+				} `xml:"nominalP"`
+				GeneratingUnitPSRTypePsrType string `xml:"generatingUnit_PSRType.psrType"` // B02, B02, B14, B14, B10, ...
+				GeneratingUnitLocationName   string `xml:"generatingUnit_Location.name"`   // Chvaletice, Chvaletice, ...
+			} `xml:"GeneratingUnit_PowerSystemResources"`
+		} `xml:"MktPSRType"`
+	} `xml:"TimeSeries"`
+}